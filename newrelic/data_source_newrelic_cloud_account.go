@@ -15,7 +15,7 @@ import (
 func dataSourceNewRelicCloudAccount() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceNewRelicCloudAccountRead,
-		Schema: map[string]*schema.Schema{
+		Schema: mergeSchemas(map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -31,7 +31,7 @@ func dataSourceNewRelicCloudAccount() *schema.Resource {
 				Required:    true,
 				Description: "The name of the cloud account.",
 			},
-		},
+		}, cloudLinkedAccountDetailsSchema()),
 	}
 }
 
@@ -83,5 +83,11 @@ func flattenCloudAccount(account *cloud.CloudLinkedAccount, d *schema.ResourceDa
 		return err
 	}
 
+	for k, v := range flattenCloudLinkedAccountDetails(account) {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -20,7 +20,10 @@ func TestAccNewRelicCloudAccountDataSource_Basic(t *testing.T) {
 			{
 				Config: testNewRelicCloudAccountDataSourceBasicConfig(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckNewRelicCloudAccountDataSourceExists("data.newrelic_cloud_account.account")),
+					testAccCheckNewRelicCloudAccountDataSourceExists("data.newrelic_cloud_account.account"),
+					resource.TestCheckResourceAttrSet("data.newrelic_cloud_account.account", "external_id"),
+					resource.TestCheckResourceAttr("data.newrelic_cloud_account.account", "metric_collection_mode", "PULL"),
+				),
 			},
 		},
 	})
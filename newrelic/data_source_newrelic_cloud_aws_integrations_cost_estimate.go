@@ -0,0 +1,238 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// The number of seconds in the 30 days month the estimates are made for.
+	cloudAwsCostEstimateSecondsPerMonth = 30 * 24 * 60 * 60
+	// The maximum number of metrics a single GetMetricData call can request.
+	cloudAwsCostEstimateMetricsPerCall = 500
+)
+
+// The CloudWatch metrics New Relic collects for a resource of an AWS service. The
+// default polling interval of the service comes from the integrations catalog.
+type cloudAwsIntegrationCostProfile struct {
+	// The approximate number of CloudWatch metrics collected for each resource.
+	metricsPerResource int
+	// The period of the CloudWatch metrics of the service, in seconds.
+	metricPeriod int
+}
+
+// Approximate profiles of the most common AWS integrations. The number of metrics
+// collected depends on the configuration of each resource, so `metrics_per_resource`
+// can be set to refine an estimate, or to estimate a service not listed here.
+var cloudAwsIntegrationCostProfiles = map[string]cloudAwsIntegrationCostProfile{
+	"alb":              {metricsPerResource: 20, metricPeriod: 60},
+	"api_gateway":      {metricsPerResource: 7, metricPeriod: 60},
+	"auto_scaling":     {metricsPerResource: 8, metricPeriod: 60},
+	"cloudfront":       {metricsPerResource: 6, metricPeriod: 60},
+	"dynamodb":         {metricsPerResource: 20, metricPeriod: 60},
+	"ebs":              {metricsPerResource: 10, metricPeriod: 300},
+	"ec2":              {metricsPerResource: 14, metricPeriod: 300},
+	"ecs":              {metricsPerResource: 4, metricPeriod: 60},
+	"efs":              {metricsPerResource: 10, metricPeriod: 60},
+	"elasticache":      {metricsPerResource: 30, metricPeriod: 60},
+	"elasticbeanstalk": {metricsPerResource: 10, metricPeriod: 60},
+	"elasticsearch":    {metricsPerResource: 25, metricPeriod: 60},
+	"elb":              {metricsPerResource: 12, metricPeriod: 60},
+	"emr":              {metricsPerResource: 30, metricPeriod: 300},
+	"kinesis":          {metricsPerResource: 15, metricPeriod: 60},
+	"lambda":           {metricsPerResource: 8, metricPeriod: 60},
+	"rds":              {metricsPerResource: 25, metricPeriod: 60},
+	"redshift":         {metricsPerResource: 20, metricPeriod: 60},
+	"route53":          {metricsPerResource: 3, metricPeriod: 60},
+	"s3":               {metricsPerResource: 4, metricPeriod: 86400},
+	"sns":              {metricsPerResource: 6, metricPeriod: 300},
+	"sqs":              {metricsPerResource: 9, metricPeriod: 300},
+}
+
+func dataSourceNewRelicCloudAwsIntegrationsCostEstimate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicCloudAwsIntegrationsCostEstimateRead,
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The AWS services to estimate the cost of.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the service, as the blocks of the newrelic_cloud_aws_integrations resource.",
+						},
+						"resource_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of resources of the service that are monitored.",
+						},
+						"metrics_polling_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+							Description:  "The data polling interval in seconds. Defaults to the default interval of the service.",
+						},
+						"metrics_per_resource": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of CloudWatch metrics collected for each resource. Defaults to an approximation for the service.",
+						},
+						"get_metric_data_calls": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The estimated number of monthly GetMetricData calls.",
+						},
+						"metrics_requested": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The estimated number of metrics requested monthly, which CloudWatch bills.",
+						},
+						"data_points": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The estimated number of data points ingested monthly.",
+						},
+						"estimated_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The estimated monthly CloudWatch cost, in USD.",
+						},
+					},
+				},
+			},
+			"price_per_thousand_metrics": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.01,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The CloudWatch price of 1,000 metrics requested with GetMetricData, in USD.",
+			},
+			"total_get_metric_data_calls": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The estimated number of monthly GetMetricData calls of every service.",
+			},
+			"total_metrics_requested": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The estimated number of metrics requested monthly for every service.",
+			},
+			"total_data_points": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The estimated number of data points ingested monthly for every service.",
+			},
+			"total_estimated_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The estimated monthly CloudWatch cost of every service, in USD.",
+			},
+		},
+	}
+}
+
+type cloudAwsIntegrationCostEstimate struct {
+	getMetricDataCalls int
+	metricsRequested   int
+	dataPoints         int
+	cost               float64
+}
+
+// Estimates the monthly CloudWatch usage of a service. Each poll requests every metric
+// of every resource, in as few GetMetricData calls as possible. The data points ingested
+// do not depend on the polling interval, as every data point of the period is fetched.
+func estimateCloudAwsIntegrationCost(resourceCount, pollingInterval, metricsPerResource, metricPeriod int, pricePerThousand float64) cloudAwsIntegrationCostEstimate {
+	polls := cloudAwsCostEstimateSecondsPerMonth / pollingInterval
+	metrics := resourceCount * metricsPerResource
+	callsPerPoll := (metrics + cloudAwsCostEstimateMetricsPerCall - 1) / cloudAwsCostEstimateMetricsPerCall
+
+	estimate := cloudAwsIntegrationCostEstimate{
+		getMetricDataCalls: polls * callsPerPoll,
+		metricsRequested:   polls * metrics,
+		dataPoints:         metrics * (cloudAwsCostEstimateSecondsPerMonth / metricPeriod),
+	}
+	estimate.cost = math.Round(float64(estimate.metricsRequested)/1000*pricePerThousand*100) / 100
+
+	return estimate
+}
+
+func dataSourceNewRelicCloudAwsIntegrationsCostEstimateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	pricePerThousand := d.Get("price_per_thousand_metrics").(float64)
+	services := d.Get("service").([]interface{})
+
+	var total cloudAwsIntegrationCostEstimate
+	names := make([]string, 0, len(services))
+
+	for i, s := range services {
+		service := s.(map[string]interface{})
+		name := service["name"].(string)
+
+		profile, ok := cloudAwsIntegrationCostProfiles[name]
+		if !ok {
+			profile = cloudAwsIntegrationCostProfile{metricPeriod: 60}
+		}
+
+		pollingInterval := cloudAwsMetricsPollingIntervals(name).defaultInterval
+		if v := service["metrics_polling_interval"].(int); v > 0 {
+			pollingInterval = v
+		}
+
+		if v := service["metrics_per_resource"].(int); v > 0 {
+			profile.metricsPerResource = v
+		}
+
+		if profile.metricsPerResource == 0 {
+			known := make([]string, 0, len(cloudAwsIntegrationCostProfiles))
+			for k := range cloudAwsIntegrationCostProfiles {
+				known = append(known, k)
+			}
+			sort.Strings(known)
+
+			return diag.Errorf("`metrics_per_resource` must be set for service %q, which is not one of: %s", name, strings.Join(known, ", "))
+		}
+
+		estimate := estimateCloudAwsIntegrationCost(service["resource_count"].(int), pollingInterval, profile.metricsPerResource, profile.metricPeriod, pricePerThousand)
+
+		service["metrics_polling_interval"] = pollingInterval
+		service["metrics_per_resource"] = profile.metricsPerResource
+		service["get_metric_data_calls"] = estimate.getMetricDataCalls
+		service["metrics_requested"] = estimate.metricsRequested
+		service["data_points"] = estimate.dataPoints
+		service["estimated_cost"] = estimate.cost
+		services[i] = service
+
+		total.getMetricDataCalls += estimate.getMetricDataCalls
+		total.metricsRequested += estimate.metricsRequested
+		total.dataPoints += estimate.dataPoints
+		total.cost += estimate.cost
+		names = append(names, fmt.Sprintf("%s=%dx%d@%d", name, service["resource_count"].(int), profile.metricsPerResource, pollingInterval))
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s;%g", strings.Join(names, ","), pricePerThousand))))
+
+	if err := d.Set("service", services); err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("total_get_metric_data_calls", total.getMetricDataCalls)
+	_ = d.Set("total_metrics_requested", total.metricsRequested)
+	_ = d.Set("total_data_points", total.dataPoints)
+	_ = d.Set("total_estimated_cost", math.Round(total.cost*100)/100)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateCloudAwsIntegrationCost(t *testing.T) {
+	// 100 EC2 instances with 14 metrics each, polled every 5 minutes.
+	estimate := estimateCloudAwsIntegrationCost(100, 300, 14, 300, 0.01)
+
+	require.Equal(t, 8640*3, estimate.getMetricDataCalls)
+	require.Equal(t, 8640*1400, estimate.metricsRequested)
+	require.Equal(t, 1400*8640, estimate.dataPoints)
+	require.Equal(t, 120.96, estimate.cost)
+
+	// Polling every 15 minutes requests a third of the metrics, but ingests the same data points.
+	slower := estimateCloudAwsIntegrationCost(100, 900, 14, 300, 0.01)
+
+	require.Equal(t, estimate.getMetricDataCalls/3, slower.getMetricDataCalls)
+	require.Equal(t, estimate.metricsRequested/3, slower.metricsRequested)
+	require.Equal(t, estimate.dataPoints, slower.dataPoints)
+	require.Equal(t, 40.32, slower.cost)
+}
+
+func TestEstimateCloudAwsIntegrationCost_NoResources(t *testing.T) {
+	estimate := estimateCloudAwsIntegrationCost(0, 300, 14, 300, 0.01)
+
+	require.Zero(t, estimate.getMetricDataCalls)
+	require.Zero(t, estimate.metricsRequested)
+	require.Zero(t, estimate.dataPoints)
+	require.Zero(t, estimate.cost)
+}
+
+func TestCloudAwsIntegrationCostProfiles(t *testing.T) {
+	services := cloudIntegrationsServiceKeys(resourceNewRelicCloudAwsIntegrations().Schema)
+
+	for name, profile := range cloudAwsIntegrationCostProfiles {
+		require.Contains(t, services, name)
+		require.Positive(t, profile.metricsPerResource, name)
+		require.Positive(t, profile.metricPeriod, name)
+	}
+}
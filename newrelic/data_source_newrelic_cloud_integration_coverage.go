@@ -0,0 +1,391 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrtime"
+)
+
+func dataSourceNewRelicCloudIntegrationCoverage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicCloudIntegrationCoverageRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the New Relic account the cloud account is linked to.",
+			},
+			"linked_account_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the linked AWS, Azure or GCP account in New Relic.",
+			},
+			"since": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntBetween(1, 168),
+				Description:  "The number of hours in the past to look for the data and the errors of the integrations.",
+			},
+			"poll_event_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The event types the data polled by the integrations is looked for in. Defaults to the samples of the cloud integrations.",
+			},
+			"cloud_provider": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cloud provider of the linked account, e.g. aws, azure or gcp.",
+			},
+			"integrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Every integration supported for the cloud provider, sorted by service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The slug of the cloud service.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the cloud service.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the integration is enabled on the linked account.",
+						},
+						"metrics_polling_interval": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The data polling interval in seconds of the integration, when enabled.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The last time the integration was configured, in RFC3339 format, when enabled.",
+						},
+						"last_successful_poll_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the most recent data polled by the integration within `since` hours, in RFC3339 format, if any.",
+						},
+						"last_error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The most recent error reported by the integration within `since` hours, if any.",
+						},
+						"last_error_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the most recent error, in RFC3339 format.",
+						},
+					},
+				},
+			},
+			"enabled_services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The slugs of the enabled integrations.",
+			},
+			"unmonitored_services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The slugs of the supported integrations which are not enabled.",
+			},
+			"erroring_services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The slugs of the enabled integrations which reported an error within `since` hours.",
+			},
+		},
+	}
+}
+
+// The details of an enabled integration which are common to every integration type.
+// `updatedAt` is decoded separately, as integrations which were never updated carry a
+// zero time which does not survive the JSON round trip.
+type cloudIntegrationCoverage struct {
+	MetricsPollingInterval int `json:"metricsPollingInterval"`
+	Service                struct {
+		Slug string `json:"slug"`
+	} `json:"service"`
+	UpdatedAt json.RawMessage `json:"updatedAt"`
+}
+
+// The event types the polling integrations report the data of the monitored resources
+// into, with the `provider` of the data and the `providerAccountId` of the linked account.
+var cloudIntegrationCoveragePollEventTypes = []string{
+	"ApiGatewaySample",
+	"BlockDeviceSample",
+	"ComputeSample",
+	"DatastoreSample",
+	"LoadBalancerSample",
+	"NetworkSample",
+	"QueueSample",
+	"ServerlessSample",
+}
+
+type cloudIntegrationError struct {
+	message   string
+	timestamp time.Time
+}
+
+func dataSourceNewRelicCloudIntegrationCoverageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	linkedAccountID := d.Get("linked_account_id").(int)
+
+	log.Printf("[INFO] Reading the integration coverage of linked account %d", linkedAccountID)
+
+	linkedAccount, err := client.Cloud.GetLinkedAccountWithContext(ctx, accountID, linkedAccountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerSlug, services := cloudLinkedAccountProviderServices(linkedAccount)
+	if len(services) == 0 {
+		return diag.Errorf("no supported integration found for linked account %d", linkedAccountID)
+	}
+
+	since := d.Get("since").(int)
+
+	errorsQuery := fmt.Sprintf(
+		"SELECT latest(message), max(timestamp) FROM NrIntegrationError WHERE providerAccountId = '%d' FACET dataSourceName SINCE %d hours ago LIMIT MAX",
+		linkedAccountID,
+		since,
+	)
+
+	errorsResp, err := client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(errorsQuery))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	eventTypes := expandStringSlice(d.Get("poll_event_types").([]interface{}))
+	if len(eventTypes) == 0 {
+		eventTypes = cloudIntegrationCoveragePollEventTypes
+	}
+
+	pollsResp, err := client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(cloudIntegrationPollsQuery(eventTypes, linkedAccountID, since)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	integrations, enabled, unmonitored, erroring := flattenCloudIntegrationCoverage(
+		services,
+		linkedAccount.Integrations,
+		matchCloudIntegrationErrors(services, errorsResp.Results),
+		matchCloudIntegrationPolls(services, pollsResp.Results),
+	)
+
+	d.SetId(strconv.Itoa(linkedAccountID))
+	_ = d.Set("cloud_provider", providerSlug)
+
+	if err := d.Set("integrations", integrations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("enabled_services", enabled)
+	_ = d.Set("unmonitored_services", unmonitored)
+	_ = d.Set("erroring_services", erroring)
+
+	return nil
+}
+
+// Returns the slug of the provider of a linked account, and the services it supports.
+func cloudLinkedAccountProviderServices(linkedAccount *cloud.CloudLinkedAccount) (string, []cloud.CloudService) {
+	switch p := linkedAccount.Provider.(type) {
+	case *cloud.CloudAwsProvider:
+		return p.Slug, p.Services
+	case *cloud.CloudAwsGovCloudProvider:
+		return p.Slug, p.Services
+	case *cloud.CloudGcpProvider:
+		return p.Slug, p.Services
+	case *cloud.CloudBaseProvider:
+		return p.Slug, p.Services
+	case *cloud.CloudProvider:
+		return p.Slug, p.Services
+	}
+
+	return "", nil
+}
+
+// Returns the slug of the service whose slug or name the data source name, or the
+// provider, of integration events contains. The longest match wins, so that
+// `AWS Elasticsearch` events are not attributed to a hypothetical `AWS Elastic` service.
+func matchCloudService(services []cloud.CloudService, name string) string {
+	normalize := func(s string) string {
+		return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(s))
+	}
+
+	name = normalize(name)
+	if name == "" {
+		return ""
+	}
+
+	var match string
+	matchLength := 0
+	for _, s := range services {
+		for _, candidate := range []string{normalize(s.Slug), normalize(s.Name)} {
+			if candidate != "" && strings.Contains(name, candidate) && len(candidate) > matchLength {
+				match = s.Slug
+				matchLength = len(candidate)
+			}
+		}
+	}
+
+	return match
+}
+
+// Matches the `NrIntegrationError` results, faceted by `dataSourceName`, to the services.
+func matchCloudIntegrationErrors(services []cloud.CloudService, results []nrdb.NRDBResult) map[string]cloudIntegrationError {
+	errors := map[string]cloudIntegrationError{}
+
+	for _, r := range results {
+		dataSourceName, _ := r["facet"].(string)
+		message, _ := r["latest.message"].(string)
+		timestamp, _ := r["max.timestamp"].(float64)
+
+		match := matchCloudService(services, dataSourceName)
+		if match == "" {
+			log.Printf("[DEBUG] No cloud service found for integration errors of data source %q", dataSourceName)
+			continue
+		}
+
+		at := time.UnixMilli(int64(timestamp)).UTC()
+		if existing, ok := errors[match]; !ok || at.After(existing.timestamp) {
+			errors[match] = cloudIntegrationError{message: message, timestamp: at}
+		}
+	}
+
+	return errors
+}
+
+// Returns the query of the time of the most recent data of each provider the
+// integrations of the linked account polled within the last `since` hours.
+func cloudIntegrationPollsQuery(eventTypes []string, linkedAccountID int, since int) string {
+	return fmt.Sprintf(
+		"SELECT max(timestamp) FROM %s WHERE providerAccountId = '%d' FACET provider SINCE %d hours ago LIMIT MAX",
+		strings.Join(eventTypes, ", "),
+		linkedAccountID,
+		since,
+	)
+}
+
+// Matches the most recent data of each provider, such as `LambdaFunction` or
+// `RdsDbInstance`, to the services. A service polling several providers has the time of
+// its most recent data.
+func matchCloudIntegrationPolls(services []cloud.CloudService, results []nrdb.NRDBResult) map[string]time.Time {
+	polls := map[string]time.Time{}
+
+	for _, r := range results {
+		provider, _ := r["facet"].(string)
+		timestamp, _ := r["max.timestamp"].(float64)
+
+		match := matchCloudService(services, provider)
+		if match == "" {
+			log.Printf("[DEBUG] No cloud service found for the data of provider %q", provider)
+			continue
+		}
+
+		at := time.UnixMilli(int64(timestamp)).UTC()
+		if existing, ok := polls[match]; !ok || at.After(existing) {
+			polls[match] = at
+		}
+	}
+
+	return polls
+}
+
+// Returns the common details of the enabled integrations keyed by the slug of their
+// service, which every integration type carries in its `service` field.
+func cloudIntegrationsBySlug(integrations []cloud.CloudIntegrationInterface) map[string]cloudIntegrationCoverage {
+	bySlug := map[string]cloudIntegrationCoverage{}
+
+	for _, i := range integrations {
+		var coverage cloudIntegrationCoverage
+
+		data, err := json.Marshal(i)
+		if err != nil {
+			continue
+		}
+		if err := json.Unmarshal(data, &coverage); err != nil || coverage.Service.Slug == "" {
+			continue
+		}
+
+		bySlug[coverage.Service.Slug] = coverage
+	}
+
+	return bySlug
+}
+
+func flattenCloudIntegrationCoverage(services []cloud.CloudService, integrations []cloud.CloudIntegrationInterface, errors map[string]cloudIntegrationError, polls map[string]time.Time) ([]interface{}, []string, []string, []string) {
+	enabledIntegrations := cloudIntegrationsBySlug(integrations)
+
+	sorted := append([]cloud.CloudService{}, services...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Slug < sorted[j].Slug
+	})
+
+	out := make([]interface{}, 0, len(sorted))
+	enabled := []string{}
+	unmonitored := []string{}
+	erroring := []string{}
+
+	for _, s := range sorted {
+		item := map[string]interface{}{
+			"service": s.Slug,
+			"name":    s.Name,
+			"enabled": false,
+		}
+
+		coverage, ok := enabledIntegrations[s.Slug]
+		if ok {
+			item["enabled"] = true
+			item["metrics_polling_interval"] = coverage.MetricsPollingInterval
+			var updatedAt nrtime.EpochSeconds
+			if err := json.Unmarshal(coverage.UpdatedAt, &updatedAt); err == nil && time.Time(updatedAt).Unix() > 0 {
+				item["updated_at"] = time.Time(updatedAt).UTC().Format(time.RFC3339)
+			}
+			enabled = append(enabled, s.Slug)
+		} else {
+			unmonitored = append(unmonitored, s.Slug)
+		}
+
+		if at, polled := polls[s.Slug]; polled {
+			item["last_successful_poll_at"] = at.Format(time.RFC3339)
+		}
+
+		if e, hasError := errors[s.Slug]; hasError {
+			item["last_error"] = e.message
+			item["last_error_at"] = e.timestamp.Format(time.RFC3339)
+			if ok {
+				erroring = append(erroring, s.Slug)
+			}
+		}
+
+		out = append(out, item)
+	}
+
+	return out, enabled, unmonitored, erroring
+}
@@ -0,0 +1,45 @@
+//go:build integration || CLOUD
+// +build integration CLOUD
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicCloudIntegrationCoverageDataSource_Basic(t *testing.T) {
+	dataSourceName := "data.newrelic_cloud_integration_coverage.coverage"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicCloudIntegrationCoverageDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cloud_provider", "aws"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "integrations.0.service"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "unmonitored_services.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "since", "24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicCloudIntegrationCoverageDataSourceConfig() string {
+	return `
+data "newrelic_cloud_account" "account" {
+  account_id     = 3959347
+  name           = "AWS-Link-For-Acceptance-Test-DO-NOT-DELETE"
+  cloud_provider = "aws"
+}
+
+data "newrelic_cloud_integration_coverage" "coverage" {
+  account_id        = 3959347
+  linked_account_id = data.newrelic_cloud_account.account.id
+}
+`
+}
@@ -0,0 +1,104 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrtime"
+	"github.com/stretchr/testify/require"
+)
+
+var testCloudIntegrationCoverageServices = []cloud.CloudService{
+	{Slug: "rds", Name: "RDS"},
+	{Slug: "lambda", Name: "Lambda"},
+	{Slug: "elasticsearch", Name: "Elasticsearch"},
+	{Slug: "ec2", Name: "EC2"},
+}
+
+func TestMatchCloudIntegrationErrors(t *testing.T) {
+	errors := matchCloudIntegrationErrors(testCloudIntegrationCoverageServices, []nrdb.NRDBResult{
+		{"facet": "AWS Lambda", "latest.message": "Access denied", "max.timestamp": float64(1700000000000)},
+		{"facet": "aws-elasticsearch", "latest.message": "Throttled", "max.timestamp": float64(1700000060000)},
+		{"facet": "Unknown", "latest.message": "Ignored", "max.timestamp": float64(1700000000000)},
+	})
+
+	require.Len(t, errors, 2)
+	require.Equal(t, "Access denied", errors["lambda"].message)
+	require.Equal(t, time.UnixMilli(1700000000000).UTC(), errors["lambda"].timestamp)
+	require.Equal(t, "Throttled", errors["elasticsearch"].message)
+}
+
+func TestMatchCloudIntegrationPolls(t *testing.T) {
+	polls := matchCloudIntegrationPolls(testCloudIntegrationCoverageServices, []nrdb.NRDBResult{
+		{"facet": "LambdaFunction", "max.timestamp": float64(1700000000000)},
+		{"facet": "RdsDbInstance", "max.timestamp": float64(1700000000000)},
+		{"facet": "RdsDbCluster", "max.timestamp": float64(1700000060000)},
+		{"facet": "Unknown", "max.timestamp": float64(1700000000000)},
+	})
+
+	require.Equal(t, map[string]time.Time{
+		"lambda": time.UnixMilli(1700000000000).UTC(),
+		"rds":    time.UnixMilli(1700000060000).UTC(),
+	}, polls)
+}
+
+func TestCloudIntegrationPollsQuery(t *testing.T) {
+	require.Equal(t,
+		"SELECT max(timestamp) FROM ComputeSample, ServerlessSample WHERE providerAccountId = '42' FACET provider SINCE 6 hours ago LIMIT MAX",
+		cloudIntegrationPollsQuery([]string{"ComputeSample", "ServerlessSample"}, 42, 6),
+	)
+}
+
+func TestFlattenCloudIntegrationCoverage(t *testing.T) {
+	updatedAt := nrtime.EpochSeconds(time.Unix(1700000000, 0))
+
+	integrations, enabled, unmonitored, erroring := flattenCloudIntegrationCoverage(
+		testCloudIntegrationCoverageServices,
+		[]cloud.CloudIntegrationInterface{
+			&cloud.CloudLambdaIntegration{
+				MetricsPollingInterval: 300,
+				Service:                cloud.CloudService{Slug: "lambda"},
+				UpdatedAt:              updatedAt,
+			},
+			&cloud.CloudEc2Integration{
+				MetricsPollingInterval: 900,
+				Service:                cloud.CloudService{Slug: "ec2"},
+			},
+		},
+		map[string]cloudIntegrationError{
+			"lambda": {message: "Access denied", timestamp: time.Unix(1700000000, 0).UTC()},
+			"rds":    {message: "Not enabled", timestamp: time.Unix(1700000000, 0).UTC()},
+		},
+		map[string]time.Time{
+			"ec2": time.Unix(1700000300, 0).UTC(),
+		},
+	)
+
+	require.Equal(t, []string{"ec2", "lambda"}, enabled)
+	require.Equal(t, []string{"elasticsearch", "rds"}, unmonitored)
+	require.Equal(t, []string{"lambda"}, erroring)
+
+	require.Len(t, integrations, 4)
+	require.Equal(t, map[string]interface{}{
+		"service":                  "ec2",
+		"name":                     "EC2",
+		"enabled":                  true,
+		"metrics_polling_interval": 900,
+		"last_successful_poll_at":  "2023-11-14T22:18:20Z",
+	}, integrations[0])
+	require.Equal(t, map[string]interface{}{
+		"service":                  "lambda",
+		"name":                     "Lambda",
+		"enabled":                  true,
+		"metrics_polling_interval": 300,
+		"updated_at":               "2023-11-14T22:13:20Z",
+		"last_error":               "Access denied",
+		"last_error_at":            "2023-11-14T22:13:20Z",
+	}, integrations[2])
+	require.Equal(t, false, integrations[3].(map[string]interface{})["enabled"])
+}
@@ -0,0 +1,201 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+)
+
+// The providers whose linked accounts are listed when no `cloud_provider` is given.
+var cloudLinkedAccountsProviders = []string{"aws", "awsGovcloud", "azure", "gcp", "oci"}
+
+// The attributes of a linked account, shared by the newrelic_cloud_account data source
+// and the entries of the newrelic_cloud_linked_accounts data source.
+func cloudLinkedAccountDetailsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"external_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The identifier of the account in the cloud provider, e.g. the AWS account ID, the Azure subscription ID or the GCP project ID.",
+		},
+		"auth_label": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The credential of the linked account, e.g. the AWS role ARN, the Azure application ID or the GCP service account.",
+		},
+		"metric_collection_mode": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "How metrics are collected, PULL or PUSH.",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the account was linked, in RFC3339 format.",
+		},
+		"disabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the linked account is disabled.",
+		},
+		"enabled_integrations": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The slugs of the integrations enabled on the linked account.",
+		},
+	}
+}
+
+func dataSourceNewRelicCloudLinkedAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicCloudLinkedAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the New Relic account the cloud accounts are linked to.",
+			},
+			"cloud_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the accounts of this cloud provider, e.g. aws, azure or gcp.",
+			},
+			"accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The linked accounts.",
+				Elem: &schema.Resource{
+					Schema: mergeSchemas(
+						map[string]*schema.Schema{
+							"id": {
+								Type:        schema.TypeInt,
+								Computed:    true,
+								Description: "The ID of the linked account.",
+							},
+							"name": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The name of the linked account.",
+							},
+							"cloud_provider": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "The cloud provider of the linked account.",
+							},
+						},
+						cloudLinkedAccountDetailsSchema(),
+					),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicCloudLinkedAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	providers := cloudLinkedAccountsProviders
+	provider, filtered := d.GetOk("cloud_provider")
+	if filtered {
+		providers = []string{provider.(string)}
+	}
+
+	log.Printf("[INFO] Reading New Relic linked cloud accounts of account %d", accountID)
+
+	var diags diag.Diagnostics
+	linkedAccounts := []cloud.CloudLinkedAccount{}
+
+	for _, p := range providers {
+		accounts, err := client.Cloud.GetLinkedAccountsWithContext(ctx, p)
+		if err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				continue
+			}
+
+			if filtered {
+				return diag.FromErr(err)
+			}
+
+			// A provider which cannot be listed does not hide the accounts of the others.
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The %s linked accounts could not be listed", p),
+				Detail:   err.Error(),
+			})
+			continue
+		}
+
+		for _, a := range *accounts {
+			if a.NrAccountId == accountID {
+				linkedAccounts = append(linkedAccounts, a)
+			}
+		}
+	}
+
+	sort.SliceStable(linkedAccounts, func(i, j int) bool {
+		return linkedAccounts[i].ID < linkedAccounts[j].ID
+	})
+
+	out := make([]interface{}, 0, len(linkedAccounts))
+	for i := range linkedAccounts {
+		account := &linkedAccounts[i]
+		slug, _ := cloudLinkedAccountProviderServices(account)
+
+		item := flattenCloudLinkedAccountDetails(account)
+		item["id"] = account.ID
+		item["name"] = account.Name
+		item["cloud_provider"] = slug
+
+		out = append(out, item)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%d:%s", accountID, strings.Join(providers, ",")))))
+	_ = d.Set("account_id", accountID)
+
+	if err := d.Set("accounts", out); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// Flattens the attributes of cloudLinkedAccountDetailsSchema.
+func flattenCloudLinkedAccountDetails(account *cloud.CloudLinkedAccount) map[string]interface{} {
+	details := map[string]interface{}{
+		"external_id":            account.ExternalId,
+		"auth_label":             account.AuthLabel,
+		"metric_collection_mode": string(account.MetricCollectionMode),
+		"disabled":               account.Disabled,
+		"enabled_integrations":   cloudLinkedAccountEnabledIntegrations(account.Integrations),
+		"created_at":             "",
+	}
+
+	if createdAt := time.Time(account.CreatedAt); createdAt.Unix() > 0 {
+		details["created_at"] = createdAt.UTC().Format(time.RFC3339)
+	}
+
+	return details
+}
+
+// Returns the sorted slugs of the services of enabled integrations.
+func cloudLinkedAccountEnabledIntegrations(integrations []cloud.CloudIntegrationInterface) []string {
+	slugs := []string{}
+	for slug := range cloudIntegrationsBySlug(integrations) {
+		slugs = append(slugs, slug)
+	}
+
+	sort.Strings(slugs)
+
+	return slugs
+}
@@ -0,0 +1,38 @@
+//go:build integration || CLOUD
+// +build integration CLOUD
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicCloudLinkedAccountsDataSource_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testNewRelicCloudLinkedAccountsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.newrelic_cloud_linked_accounts.accounts", "accounts.*", map[string]string{
+						"name":                   "AWS-Link-For-Acceptance-Test-DO-NOT-DELETE",
+						"cloud_provider":         "aws",
+						"metric_collection_mode": "PULL",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testNewRelicCloudLinkedAccountsDataSourceConfig() string {
+	return `
+data "newrelic_cloud_linked_accounts" "accounts" {
+	account_id     = 3959347
+	cloud_provider = "aws"
+}
+`
+}
@@ -0,0 +1,43 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCloudLinkedAccountDetails(t *testing.T) {
+	account := &cloud.CloudLinkedAccount{
+		ID:                   1234,
+		AuthLabel:            "arn:aws:iam::123456789012:role/NewRelicInfrastructure-Integrations",
+		ExternalId:           "123456789012",
+		MetricCollectionMode: cloud.CloudMetricCollectionModeTypes.PULL,
+		CreatedAt:            nrtime.EpochSeconds(time.Unix(1700000000, 0)),
+		Integrations: []cloud.CloudIntegrationInterface{
+			&cloud.CloudLambdaIntegration{Service: cloud.CloudService{Slug: "lambda"}},
+			&cloud.CloudEc2Integration{Service: cloud.CloudService{Slug: "ec2"}},
+		},
+	}
+
+	details := flattenCloudLinkedAccountDetails(account)
+
+	require.Equal(t, "123456789012", details["external_id"])
+	require.Equal(t, "PULL", details["metric_collection_mode"])
+	require.Equal(t, "2023-11-14T22:13:20Z", details["created_at"])
+	require.Equal(t, false, details["disabled"])
+	require.Equal(t, []string{"ec2", "lambda"}, details["enabled_integrations"])
+}
+
+func TestFlattenCloudLinkedAccountDetails_NoIntegrations(t *testing.T) {
+	details := flattenCloudLinkedAccountDetails(&cloud.CloudLinkedAccount{Disabled: true})
+
+	require.Equal(t, "", details["created_at"])
+	require.Equal(t, true, details["disabled"])
+	require.Empty(t, details["enabled_integrations"])
+}
@@ -0,0 +1,188 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNewRelicEntityRelationships() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicEntityRelationshipsRead,
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The GUID of the entity to traverse the relationships from.",
+			},
+			"depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 5),
+				Description:  "The number of hops to traverse.",
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "both",
+				ValidateFunc: validation.StringInSlice([]string{"outgoing", "incoming", "both"}, false),
+				Description:  "Which relationships are followed: from source to target (outgoing), from target to source (incoming), or both.",
+			},
+			"types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The types of relationships to follow. Defaults to all.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(listValidEntityRelationshipTypes(), false),
+				},
+			},
+			"user_defined_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to only follow user defined relationships, leaving out the detected ones.",
+			},
+			"relationships": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The relationships followed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_entity_guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_entity_guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_defined": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"entities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The entities reached, ordered by depth and GUID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"depth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"entity_guids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The GUIDs of the entities reached, ordered by depth and GUID.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicEntityRelationshipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+	guid := d.Get("guid").(string)
+	depth := d.Get("depth").(int)
+	direction := d.Get("direction").(string)
+
+	types := []string{}
+	for _, t := range d.Get("types").(*schema.Set).List() {
+		types = append(types, t.(string))
+	}
+	sort.Strings(types)
+
+	log.Printf("[INFO] Traversing the relationships of New Relic entity %s", guid)
+
+	fetch := func(ctx context.Context, g string) ([]entityRelationshipEdge, error) {
+		edges, ok, err := getEntityRelationships(ctx, client, g)
+		if err != nil {
+			return nil, err
+		}
+		// Entities the user cannot access are reached, but not traversed.
+		if !ok && g == guid {
+			return nil, fmt.Errorf("entity %s not found", guid)
+		}
+
+		return edges, nil
+	}
+
+	edges, nodes, err := traverseEntityRelationships(ctx, guid, depth, direction, types, d.Get("user_defined_only").(bool), fetch)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	relationships := make([]interface{}, 0, len(edges))
+	for _, e := range edges {
+		relationships = append(relationships, map[string]interface{}{
+			"source_entity_guid": e.Source.GUID,
+			"target_entity_guid": e.Target.GUID,
+			"type":               e.Type,
+			"user_defined":       e.userDefined(),
+		})
+	}
+
+	reached := make([]interface{}, 0, len(nodes))
+	guids := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		entity := map[string]interface{}{
+			"guid":  n.vertex.GUID,
+			"depth": n.depth,
+		}
+		if n.vertex.Entity != nil {
+			entity["name"] = n.vertex.Entity.Name
+			entity["type"] = n.vertex.Entity.Type
+			entity["domain"] = n.vertex.Entity.Domain
+		}
+		reached = append(reached, entity)
+		guids = append(guids, n.vertex.GUID)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s:%d:%s:%s:%t", guid, depth, direction, strings.Join(types, ","), d.Get("user_defined_only").(bool)))))
+	_ = d.Set("entity_guids", guids)
+
+	if err := d.Set("relationships", relationships); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("entities", reached); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
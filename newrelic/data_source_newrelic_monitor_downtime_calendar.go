@@ -0,0 +1,361 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNewRelicMonitorDowntimeCalendar() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicMonitorDowntimeCalendarRead,
+		Schema: map[string]*schema.Schema{
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The start of the period to expand the downtimes and muting rules in, in RFC3339 format. Defaults to the current time.",
+			},
+			"days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 366),
+				Description:  "The number of days after `from` to expand the downtimes and muting rules in.",
+			},
+			"downtime": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A Monitor Downtime to expand, with the same arguments as the `newrelic_monitor_downtime` resource.",
+				Elem:        monitorDowntimeCalendarDowntimeSchema(),
+			},
+			"muting_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The schedule of a muting rule to expand, with the same arguments as the `schedule` of the `newrelic_alert_muting_rule` resource.",
+				Elem:        monitorDowntimeCalendarMutingRuleSchema(),
+			},
+			"windows": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The windows of the downtimes and muting rules in the period, ordered by start time.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the window belongs to a `downtime` or a `muting_rule`.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the downtime or muting rule.",
+						},
+						"start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start of the window, in RFC3339 format in UTC.",
+						},
+						"end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end of the window, in RFC3339 format in UTC.",
+						},
+						"monitor_guids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The GUIDs of the monitors covered by the window.",
+						},
+					},
+				},
+			},
+			"overlaps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The periods during which a monitor is covered by two different downtimes or muting rules.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitor_guid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The GUID of the monitor.",
+						},
+						"first_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the first overlapping window belongs to a `downtime` or a `muting_rule`.",
+						},
+						"first_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the first overlapping downtime or muting rule.",
+						},
+						"second_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the second overlapping window belongs to a `downtime` or a `muting_rule`.",
+						},
+						"second_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the second overlapping downtime or muting rule.",
+						},
+						"start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start of the overlap, in RFC3339 format in UTC.",
+						},
+						"end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end of the overlap, in RFC3339 format in UTC.",
+						},
+					},
+				},
+			},
+			"overlapping_monitor_guids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The GUIDs of the monitors covered by overlapping downtimes or muting rules in the period.",
+			},
+		},
+	}
+}
+
+func monitorDowntimeCalendarDowntimeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Monitor Downtime.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The mode of the Monitor Downtime.",
+				ValidateFunc: validation.StringInSlice([]string{SyntheticsMonitorDowntimeModes.OneTime, SyntheticsMonitorDowntimeModes.DAILY, SyntheticsMonitorDowntimeModes.WEEKLY, SyntheticsMonitorDowntimeModes.MONTHLY}, false),
+			},
+			"monitor_guids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The GUIDs of the monitors the Monitor Downtime applies to.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A datetime stamp signifying the start of the Monitor Downtime.",
+				ValidateFunc: validateNaiveDateTime,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A datetime stamp signifying the end of the Monitor Downtime.",
+				ValidateFunc: validateNaiveDateTime,
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The timezone that applies to the Monitor Downtime schedule.",
+				ValidateFunc: validateMonitorDowntimeTimeZone,
+			},
+			"end_repeat": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "A specification of when the Monitor Downtime should end its repeat cycle, by number of occurrences or date.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"on_date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "A date, on which the Monitor Downtime's repeat cycle is expected to end.",
+							ValidateFunc: validateMonitorDowntimeOnDate,
+						},
+						"on_repeat": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Number of repetitions after which the Monitor Downtime's repeat cycle is expected to end.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"maintenance_days": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(listValidWeekDayValues(), false)},
+				Optional:    true,
+				Description: "A list of maintenance days of a weekly Monitor Downtime.",
+			},
+			"frequency": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Configuration options for which days of the month a monthly Monitor Downtime will occur.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_of_month": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(1, 31)},
+							Optional:    true,
+							Description: "A numerical list of days of a month on which the Monitor Downtime is scheduled to run.",
+						},
+						"days_of_week": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "A day of the week on which the Monitor Downtime is scheduled to run.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ordinal_day_of_month": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "An occurrence of the day selected within the month.",
+										ValidateFunc: validation.StringInSlice(listValidOrdinalDayOfMonthValues(), false),
+									},
+									"week_day": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Description:  "The day of the week on which the Monitor Downtime would run.",
+										ValidateFunc: validation.StringInSlice(listValidWeekDayValues(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func monitorDowntimeCalendarMutingRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the MutingRule.",
+			},
+			"monitor_guids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The GUIDs of the monitors whose alerts are muted by the MutingRule.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The datetime stamp representing when the MutingRule should start.",
+				ValidateFunc: validateNaiveDateTime,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The datetime stamp representing when the MutingRule should end.",
+				ValidateFunc: validateNaiveDateTime,
+			},
+			"time_zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The time zone that applies to the MutingRule schedule.",
+				ValidateFunc: validateMonitorDowntimeTimeZone,
+			},
+			"repeat": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The frequency the MutingRule schedule repeats. One of [DAILY, WEEKLY, MONTHLY]",
+				ValidateFunc: validation.StringInSlice([]string{"DAILY", "WEEKLY", "MONTHLY"}, false),
+			},
+			"end_repeat": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The datetime stamp when the MutingRule schedule should stop repeating.",
+				ValidateFunc: validateNaiveDateTime,
+			},
+			"repeat_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of times the MutingRule schedule should repeat.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"weekly_repeat_days": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice([]string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}, false)},
+				Optional:    true,
+				Description: "The day(s) of the week that a MutingRule should repeat when the repeat field is set to WEEKLY.",
+				MaxItems:    7,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicMonitorDowntimeCalendarRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Expanding Monitor Downtime calendar")
+
+	from := time.Now().UTC().Truncate(time.Second)
+	if v, ok := d.GetOk("from"); ok {
+		parsed, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		from = parsed.UTC()
+	}
+	until := from.AddDate(0, 0, d.Get("days").(int))
+
+	schedules := []*monitorDowntimeCalendarSchedule{}
+
+	for _, v := range d.Get("downtime").([]interface{}) {
+		schedule, err := expandMonitorDowntimeCalendarDowntime(v.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	for _, v := range d.Get("muting_rule").([]interface{}) {
+		schedule, err := expandMonitorDowntimeCalendarMutingRule(v.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	windows := []monitorDowntimeCalendarWindow{}
+	for i, s := range schedules {
+		windows = append(windows, expandMonitorDowntimeCalendarWindows(s, i, from, until)...)
+	}
+
+	sort.SliceStable(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+
+	overlaps, overlappingMonitorGUIDs := flattenMonitorDowntimeCalendarOverlaps(schedules, findMonitorDowntimeCalendarOverlaps(schedules, windows))
+
+	d.SetId(fmt.Sprintf("%s/%s", from.Format(time.RFC3339), until.Format(time.RFC3339)))
+	_ = d.Set("from", from.Format(time.RFC3339))
+
+	if err := d.Set("windows", flattenMonitorDowntimeCalendarWindows(schedules, windows)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("overlaps", overlaps); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("overlapping_monitor_guids", overlappingMonitorGUIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+)
+
+func dataSourceNewRelicServiceLevelStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicServiceLevelStatusRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account the service level metrics are queried from. Defaults to the account of the events of the service level.",
+			},
+			"sli_guid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringIsNotWhiteSpace, validation.StringDoesNotContainAny("'")),
+				Description:  "The GUID of the service level indicator.",
+			},
+			"burn_rate_windows": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The windows to compute the burn rate over, in seconds. Defaults to 1 hour, 6 hours and 1 day.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(60),
+				},
+			},
+			"slo_target": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The target of the objective of the service level.",
+			},
+			"slo_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The period of the objective of the service level, in days.",
+			},
+			"attainment": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of good events over the period of the objective.",
+			},
+			"error_budget_remaining": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of the error budget which remains over the period of the objective. Negative when the budget is overspent.",
+			},
+			"error_budget_exhausted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the error budget is exhausted.",
+			},
+			"burn_rates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The burn rate over each window.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"window": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"burn_rate": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"projected_exhaustion_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the error budget is projected to be exhausted at the burn rate of the longest window, in RFC3339 format. Empty when the budget is not being consumed.",
+			},
+		},
+	}
+}
+
+var serviceLevelStatusDefaultWindows = []int{3600, 21600, 86400}
+
+type serviceLevelStatus struct {
+	attainment          float64
+	budgetRemaining     float64
+	burnRates           map[int]float64
+	projectedExhaustion *time.Time
+	budgetExhausted     bool
+}
+
+func dataSourceNewRelicServiceLevelStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	sliGUID := d.Get("sli_guid").(string)
+
+	log.Printf("[INFO] Reading the status of New Relic service level %s", sliGUID)
+
+	indicator, err := getServiceLevelAlertIndicator(ctx, client, sliGUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sloTarget, sloPeriod, err := serviceLevelAlertObjective(indicator)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := indicator.Events.Account.ID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(int)
+	}
+	if accountID == 0 {
+		accountID = selectAccountID(providerConfig, d)
+	}
+
+	windows := serviceLevelStatusDefaultWindows
+	if v, ok := d.GetOk("burn_rate_windows"); ok {
+		windows = expandIntList(v.([]interface{}))
+	}
+
+	errorRateQuery := serviceLevelAlertNrql(sliGUID, indicator.Events.BadEvents != nil)
+	queryErrorRate := func(seconds int) (float64, error) {
+		resp, err := client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(fmt.Sprintf("%s SINCE %d seconds ago", errorRateQuery, seconds)))
+		if err != nil {
+			return 0, err
+		}
+
+		// Without valid events there is no error.
+		if len(resp.Results) == 0 {
+			return 0, nil
+		}
+		errorRate, _ := resp.Results[0]["Error rate"].(float64)
+
+		return errorRate, nil
+	}
+
+	periodErrorRate, err := queryErrorRate(sloPeriod * 86400)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	windowErrorRates := map[int]float64{}
+	for _, w := range windows {
+		if windowErrorRates[w], err = queryErrorRate(w); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	status := computeServiceLevelStatus(sloTarget, sloPeriod, periodErrorRate, windowErrorRates, time.Now())
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s:%v", sliGUID, windows))))
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("slo_target", sloTarget)
+	_ = d.Set("slo_period", sloPeriod)
+	_ = d.Set("attainment", status.attainment)
+	_ = d.Set("error_budget_remaining", status.budgetRemaining)
+	_ = d.Set("error_budget_exhausted", status.budgetExhausted)
+
+	burnRates := make([]interface{}, 0, len(windows))
+	for _, w := range windows {
+		burnRates = append(burnRates, map[string]interface{}{
+			"window":    w,
+			"burn_rate": status.burnRates[w],
+		})
+	}
+	if err := d.Set("burn_rates", burnRates); err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectedExhaustion := ""
+	if status.projectedExhaustion != nil {
+		projectedExhaustion = status.projectedExhaustion.UTC().Format(time.RFC3339)
+	}
+	_ = d.Set("projected_exhaustion_time", projectedExhaustion)
+
+	return nil
+}
+
+// Computes the status of the error budget from the error rates, in percent, over the
+// period of the objective and over the burn rate windows. A burn rate of 1 consumes
+// the whole budget over the period of the objective.
+func computeServiceLevelStatus(sloTarget float64, sloPeriod int, periodErrorRate float64, windowErrorRates map[int]float64, now time.Time) serviceLevelStatus {
+	budget := 100 - sloTarget
+
+	status := serviceLevelStatus{
+		attainment: 100 - periodErrorRate,
+		burnRates:  map[int]float64{},
+	}
+
+	if budget <= 0 {
+		status.budgetExhausted = periodErrorRate > 0
+		return status
+	}
+
+	status.budgetRemaining = 100 - periodErrorRate/budget*100
+	status.budgetExhausted = status.budgetRemaining <= 0
+
+	for w, errorRate := range windowErrorRates {
+		status.burnRates[w] = errorRate / budget
+	}
+
+	if status.budgetExhausted {
+		status.projectedExhaustion = &now
+		return status
+	}
+
+	windows := make([]int, 0, len(status.burnRates))
+	for w := range status.burnRates {
+		windows = append(windows, w)
+	}
+	sort.Ints(windows)
+
+	if len(windows) == 0 || status.burnRates[windows[len(windows)-1]] <= 0 {
+		return status
+	}
+
+	// The remaining budget lasts the remaining fraction of the period at a burn rate of 1.
+	burnRate := status.burnRates[windows[len(windows)-1]]
+	remaining := time.Duration(status.budgetRemaining / 100 * float64(sloPeriod) * 24 * float64(time.Hour) / burnRate)
+	exhaustion := now.Add(remaining)
+	status.projectedExhaustion = &exhaustion
+
+	return status
+}
@@ -0,0 +1,42 @@
+//go:build integration || WORKLOADS
+// +build integration WORKLOADS
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicServiceLevelStatusDataSource_Basic(t *testing.T) {
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnvVars(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicServiceLevelStatusDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.newrelic_service_level_status.status", "slo_target", "99"),
+					resource.TestCheckResourceAttr("data.newrelic_service_level_status.status", "slo_period", "7"),
+					resource.TestCheckResourceAttr("data.newrelic_service_level_status.status", "burn_rates.#", "2"),
+					resource.TestCheckResourceAttrSet("data.newrelic_service_level_status.status", "error_budget_remaining"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicServiceLevelStatusDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "newrelic_service_level_status" "status" {
+	sli_guid          = newrelic_service_level.sli.sli_guid
+	burn_rate_windows = [3600, 86400]
+}
+`, testAccNewRelicServiceLevelConfig(name))
+}
@@ -0,0 +1,47 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeServiceLevelStatus(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// An error rate of 0.05% over 28 days consumes half of the 0.1% budget of a 99.9% target.
+	status := computeServiceLevelStatus(99.9, 28, 0.05, map[int]float64{3600: 0.2, 86400: 0.1}, now)
+
+	require.InDelta(t, 99.95, status.attainment, 1e-9)
+	require.InDelta(t, 50, status.budgetRemaining, 1e-9)
+	require.False(t, status.budgetExhausted)
+	require.InDelta(t, 2, status.burnRates[3600], 1e-9)
+	require.InDelta(t, 1, status.burnRates[86400], 1e-9)
+
+	// At the burn rate of the longest window, the remaining half of the budget lasts half the period.
+	require.NotNil(t, status.projectedExhaustion)
+	require.WithinDuration(t, now.Add(14*24*time.Hour), *status.projectedExhaustion, time.Second)
+}
+
+func TestComputeServiceLevelStatus_Exhausted(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	status := computeServiceLevelStatus(99.9, 7, 0.15, map[int]float64{3600: 0.3}, now)
+
+	require.InDelta(t, -50, status.budgetRemaining, 1e-9)
+	require.True(t, status.budgetExhausted)
+	require.Equal(t, now, *status.projectedExhaustion)
+}
+
+func TestComputeServiceLevelStatus_NoErrors(t *testing.T) {
+	status := computeServiceLevelStatus(99, 7, 0, map[int]float64{3600: 0}, time.Now())
+
+	require.Equal(t, float64(100), status.attainment)
+	require.Equal(t, float64(100), status.budgetRemaining)
+	require.False(t, status.budgetExhausted)
+	require.Nil(t, status.projectedExhaustion)
+}
@@ -0,0 +1,203 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// A query of the events of a service level indicator, as the `valid_events`,
+// `good_events` and `bad_events` blocks of newrelic_service_level.
+type serviceLevelTemplateQuery struct {
+	from  string
+	where string
+}
+
+type serviceLevelTemplate struct {
+	valid *serviceLevelTemplateQuery
+	good  *serviceLevelTemplateQuery
+	bad   *serviceLevelTemplateQuery
+}
+
+// The kinds of service level indicators, and the default threshold of the ones which
+// compare an attribute to one. Thresholds are in milliseconds, except for kafka_lag
+// whose threshold is a number of messages.
+var serviceLevelTemplateKinds = map[string]float64{
+	"apm_latency":            500,
+	"apm_success_rate":       0,
+	"browser_lcp":            2500,
+	"synthetic_availability": 0,
+	"kafka_lag":              1000,
+}
+
+func dataSourceNewRelicServiceLevelTemplate() *schema.Resource {
+	kinds := make([]string, 0, len(serviceLevelTemplateKinds))
+	for kind := range serviceLevelTemplateKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicServiceLevelTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account the events are queried from.",
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(kinds, false),
+				Description:  "The kind of service level indicator.",
+			},
+			"entity_guid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.StringIsNotWhiteSpace, validation.StringDoesNotContainAny("'")),
+				Description:  "The GUID of the entity the service level indicator measures.",
+			},
+			"threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The threshold of good events: a duration in milliseconds for apm_latency and browser_lcp, a number of messages for kafka_lag.",
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The events of the service level indicator, as the events block of newrelic_service_level.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"valid_events": serviceLevelTemplateQuerySchema(),
+						"good_events":  serviceLevelTemplateQuerySchema(),
+						"bad_events":   serviceLevelTemplateQuerySchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func serviceLevelTemplateQuerySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"where": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicServiceLevelTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accountID := selectAccountID(meta.(*ProviderConfig), d)
+	kind := d.Get("kind").(string)
+	entityGUID := d.Get("entity_guid").(string)
+
+	threshold := serviceLevelTemplateKinds[kind]
+	if v, ok := d.GetOk("threshold"); ok {
+		if threshold == 0 {
+			return diag.Errorf("`threshold` is not supported by the %s kind", kind)
+		}
+		threshold = v.(float64)
+	}
+
+	template := expandServiceLevelTemplate(kind, entityGUID, threshold)
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%d:%s:%s:%g", accountID, kind, entityGUID, threshold))))
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("threshold", threshold)
+
+	return diag.FromErr(d.Set("events", flattenServiceLevelTemplate(template, accountID)))
+}
+
+// Returns the queries of a kind of service level indicator, for an entity.
+func expandServiceLevelTemplate(kind string, entityGUID string, threshold float64) serviceLevelTemplate {
+	entity := fmt.Sprintf("entityGuid = '%s'", entityGUID)
+
+	// The APM kinds only measure web transactions, as background jobs have their own
+	// latency and error patterns. Transaction errors don't carry the type of their
+	// transaction, only its name, which is prefixed by the type.
+	webTransactions := fmt.Sprintf("%s AND transactionType = 'Web'", entity)
+	webTransactionErrors := fmt.Sprintf("%s AND transactionName LIKE 'WebTransaction/%%'", entity)
+
+	switch kind {
+	case "apm_latency":
+		return serviceLevelTemplate{
+			valid: &serviceLevelTemplateQuery{from: "Transaction", where: webTransactions},
+			good:  &serviceLevelTemplateQuery{from: "Transaction", where: fmt.Sprintf("%s AND duration < %s", webTransactions, formatServiceLevelTemplateSeconds(threshold))},
+		}
+	case "apm_success_rate":
+		return serviceLevelTemplate{
+			valid: &serviceLevelTemplateQuery{from: "Transaction", where: webTransactions},
+			bad:   &serviceLevelTemplateQuery{from: "TransactionError", where: fmt.Sprintf("%s AND error.expected IS FALSE", webTransactionErrors)},
+		}
+	case "browser_lcp":
+		valid := fmt.Sprintf("%s AND timingName = 'largestContentfulPaint'", entity)
+		return serviceLevelTemplate{
+			valid: &serviceLevelTemplateQuery{from: "PageViewTiming", where: valid},
+			good:  &serviceLevelTemplateQuery{from: "PageViewTiming", where: fmt.Sprintf("%s AND largestContentfulPaint < %s", valid, formatServiceLevelTemplateSeconds(threshold))},
+		}
+	case "synthetic_availability":
+		return serviceLevelTemplate{
+			valid: &serviceLevelTemplateQuery{from: "SyntheticCheck", where: entity},
+			bad:   &serviceLevelTemplateQuery{from: "SyntheticCheck", where: fmt.Sprintf("%s AND result = 'FAILED'", entity)},
+		}
+	case "kafka_lag":
+		return serviceLevelTemplate{
+			valid: &serviceLevelTemplateQuery{from: "KafkaOffsetSample", where: entity},
+			good:  &serviceLevelTemplateQuery{from: "KafkaOffsetSample", where: fmt.Sprintf("%s AND consumer.lag <= %s", entity, strconv.FormatFloat(threshold, 'f', -1, 64))},
+		}
+	}
+
+	return serviceLevelTemplate{}
+}
+
+// Durations are recorded in seconds, while thresholds are given in milliseconds.
+func formatServiceLevelTemplateSeconds(milliseconds float64) string {
+	return strconv.FormatFloat(milliseconds/1000, 'f', -1, 64)
+}
+
+func flattenServiceLevelTemplate(template serviceLevelTemplate, accountID int) []interface{} {
+	flattenQuery := func(query *serviceLevelTemplateQuery) []interface{} {
+		if query == nil {
+			return []interface{}{}
+		}
+
+		return []interface{}{
+			map[string]interface{}{
+				"from":  query.from,
+				"where": query.where,
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"account_id":   accountID,
+			"valid_events": flattenQuery(template.valid),
+			"good_events":  flattenQuery(template.good),
+			"bad_events":   flattenQuery(template.bad),
+		},
+	}
+}
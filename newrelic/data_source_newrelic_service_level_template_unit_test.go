@@ -0,0 +1,86 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandServiceLevelTemplate(t *testing.T) {
+	guid := "MXxBUE18QVBQTElDQVRJT058MQ"
+
+	cases := map[string]struct {
+		threshold float64
+		expected  serviceLevelTemplate
+	}{
+		"apm_latency": {
+			threshold: 500,
+			expected: serviceLevelTemplate{
+				valid: &serviceLevelTemplateQuery{from: "Transaction", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ' AND transactionType = 'Web'"},
+				good:  &serviceLevelTemplateQuery{from: "Transaction", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ' AND transactionType = 'Web' AND duration < 0.5"},
+			},
+		},
+		"apm_success_rate": {
+			expected: serviceLevelTemplate{
+				valid: &serviceLevelTemplateQuery{from: "Transaction", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ' AND transactionType = 'Web'"},
+				bad:   &serviceLevelTemplateQuery{from: "TransactionError", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ' AND transactionName LIKE 'WebTransaction/%' AND error.expected IS FALSE"},
+			},
+		},
+		"browser_lcp": {
+			threshold: 2500,
+			expected: serviceLevelTemplate{
+				valid: &serviceLevelTemplateQuery{from: "PageViewTiming", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ' AND timingName = 'largestContentfulPaint'"},
+				good:  &serviceLevelTemplateQuery{from: "PageViewTiming", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ' AND timingName = 'largestContentfulPaint' AND largestContentfulPaint < 2.5"},
+			},
+		},
+		"synthetic_availability": {
+			expected: serviceLevelTemplate{
+				valid: &serviceLevelTemplateQuery{from: "SyntheticCheck", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ'"},
+				bad:   &serviceLevelTemplateQuery{from: "SyntheticCheck", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ' AND result = 'FAILED'"},
+			},
+		},
+		"kafka_lag": {
+			threshold: 1000,
+			expected: serviceLevelTemplate{
+				valid: &serviceLevelTemplateQuery{from: "KafkaOffsetSample", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ'"},
+				good:  &serviceLevelTemplateQuery{from: "KafkaOffsetSample", where: "entityGuid = 'MXxBUE18QVBQTElDQVRJT058MQ' AND consumer.lag <= 1000"},
+			},
+		},
+	}
+
+	require.Len(t, cases, len(serviceLevelTemplateKinds))
+
+	for kind, c := range cases {
+		t.Run(kind, func(t *testing.T) {
+			template := expandServiceLevelTemplate(kind, guid, c.threshold)
+			require.Equal(t, c.expected, template)
+
+			// A service level requires valid events, and either good or bad events.
+			require.NotNil(t, template.valid)
+			require.True(t, (template.good == nil) != (template.bad == nil))
+		})
+	}
+}
+
+func TestExpandServiceLevelTemplate_Threshold(t *testing.T) {
+	template := expandServiceLevelTemplate("apm_latency", "guid", 250)
+	require.Equal(t, "entityGuid = 'guid' AND transactionType = 'Web' AND duration < 0.25", template.good.where)
+
+	template = expandServiceLevelTemplate("kafka_lag", "guid", 12.5)
+	require.Equal(t, "entityGuid = 'guid' AND consumer.lag <= 12.5", template.good.where)
+}
+
+func TestFlattenServiceLevelTemplate(t *testing.T) {
+	events := flattenServiceLevelTemplate(expandServiceLevelTemplate("apm_success_rate", "guid", 0), 12345)
+
+	require.Len(t, events, 1)
+
+	e := events[0].(map[string]interface{})
+	require.Equal(t, 12345, e["account_id"])
+	require.Len(t, e["valid_events"], 1)
+	require.Empty(t, e["good_events"])
+	require.Len(t, e["bad_events"], 1)
+}
@@ -0,0 +1,364 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+)
+
+func dataSourceNewRelicSyntheticsMonitorResults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsMonitorResultsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the account in New Relic.",
+			},
+			"guid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"guid", "name"},
+				Description:  "The GUID of the Synthetics monitor.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"guid", "name"},
+				Description:  "The name of the Synthetics monitor.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The number of most recent check results to return for each location.",
+			},
+			"since": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(1, 10080),
+				Description:  "The number of minutes in the past to look for check results.",
+			},
+			"wait_for_success": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to poll the check results until the most recent check of every location of the monitor succeeded, or the read timeout expires. Only checks which ran after `checks_after`, or after the read started, are taken into account.",
+			},
+			"checks_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only return check results which ran after this time, in RFC3339 format.",
+			},
+			"monitor_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the monitor, as reported in Synthetics events.",
+			},
+			"success": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the most recent check of every location of the monitor succeeded. `false` when a location has no check.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The most recent check results of each location, ordered by location and most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The location the check ran from.",
+						},
+						"location_label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the location the check ran from.",
+						},
+						"timestamp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the check ran, in RFC3339 format.",
+						},
+						"result": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The result of the check, such as `SUCCESS` or `FAILED`.",
+						},
+						"success": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the check succeeded.",
+						},
+						"duration": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The duration of the check, in milliseconds.",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error reported by the check, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsMonitorResultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading Synthetics monitor results")
+
+	guid := d.Get("guid").(string)
+	name := d.Get("name").(string)
+	if guid == "" {
+		monitor, err := findSyntheticsMonitorByName(ctx, client, accountID, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		guid = string(monitor.GetGUID())
+	}
+
+	monitorID, err := getMonitorID(guid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entity, err := client.Entities.GetEntityWithContext(ctx, common.EntityGUID(guid))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if entity == nil || *entity == nil {
+		return diag.Errorf("no Synthetics monitor found with GUID %s", guid)
+	}
+
+	monitor, ok := (*entity).(*entities.SyntheticMonitorEntity)
+	if !ok {
+		return diag.Errorf("entity %s is not a Synthetics monitor", guid)
+	}
+
+	locations := getSyntheticsMonitorLocations(monitor.GetTags())
+
+	// Every location has to run a check before the monitor can be green, which takes up
+	// to a period of the monitor.
+	period := time.Duration(monitor.GetPeriod()) * time.Minute
+	if d.Get("wait_for_success").(bool) && d.Timeout(schema.TimeoutRead) < period {
+		return diag.Errorf("the read timeout (%s) is shorter than the period of monitor %s (%s), so not every location would run a check before it expires", d.Timeout(schema.TimeoutRead), guid, period)
+	}
+
+	// Checks which ran before the read started don't tell whether the monitor is green now.
+	var after time.Time
+	if checksAfter, ok := d.GetOk("checks_after"); ok {
+		after, _ = time.Parse(time.RFC3339, checksAfter.(string))
+	} else if d.Get("wait_for_success").(bool) {
+		after = time.Now()
+	}
+
+	query := syntheticsMonitorResultsQuery(monitorID, d.Get("since").(int), after)
+	limit := d.Get("limit").(int)
+
+	var results []interface{}
+	var success bool
+
+	readResults := func() error {
+		resp, queryErr := client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(query))
+		if queryErr != nil {
+			return queryErr
+		}
+
+		results, success = flattenSyntheticsMonitorResults(resp.Results, limit, locations)
+		return nil
+	}
+
+	if d.Get("wait_for_success").(bool) {
+		retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+			if readErr := readResults(); readErr != nil {
+				return resource.NonRetryableError(readErr)
+			}
+
+			if !success {
+				return resource.RetryableError(fmt.Errorf("the most recent checks of monitor %s did not all succeed yet", guid))
+			}
+
+			return nil
+		})
+
+		if retryErr != nil {
+			return diag.FromErr(retryErr)
+		}
+	} else if err := readResults(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(guid)
+	_ = d.Set("guid", guid)
+	_ = d.Set("monitor_id", monitorID)
+	_ = d.Set("success", success)
+
+	_ = d.Set("name", monitor.GetName())
+
+	if err := d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Returns the query of the `SyntheticCheck` results of the monitor within the last `since`
+// minutes, leaving out the checks which ran before `after` unless it is zero.
+func syntheticsMonitorResultsQuery(monitorID string, since int, after time.Time) string {
+	where := fmt.Sprintf("monitorId = '%s'", escapeSingleQuote(monitorID))
+	if !after.IsZero() {
+		where += fmt.Sprintf(" AND timestamp > %d", after.UnixMilli())
+	}
+
+	return fmt.Sprintf(
+		"SELECT timestamp, result, duration, error, location, locationLabel FROM SyntheticCheck WHERE %s SINCE %d minutes ago LIMIT MAX",
+		where,
+		since,
+	)
+}
+
+// Looks up a Synthetics monitor entity by its name within the given account.
+func findSyntheticsMonitorByName(ctx context.Context, client *newrelic.NewRelic, accountID int, name string) (entities.EntityOutlineInterface, error) {
+	query := fmt.Sprintf("domain = 'SYNTH' AND type = 'MONITOR' AND name = '%s'", escapeSingleQuote(name))
+	entitySearch, err := client.Entities.GetEntitySearchByQueryWithContext(
+		ctx,
+		entities.EntitySearchOptions{},
+		query,
+		[]entities.EntitySearchSortCriteria{},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range entitySearch.Results.Entities {
+		if m.GetAccountID() == accountID && m.GetName() == name {
+			return m, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no matches found for monitor with name '%s'", name)
+}
+
+// Returns the locations a Synthetics monitor runs from, as reported in its check results:
+// the labels of its public locations, and the IDs of its private locations.
+func getSyntheticsMonitorLocations(tags []entities.EntityTag) []string {
+	locations := []string{}
+
+	for _, t := range tags {
+		switch t.Key {
+		case "publicLocation":
+			locations = append(locations, t.Values...)
+		case "privateLocation":
+			for _, guid := range t.Values {
+				if id, err := getPrivateLocationID(guid); err == nil {
+					locations = append(locations, id)
+				}
+			}
+		}
+	}
+
+	sort.Strings(locations)
+
+	return locations
+}
+
+// Keeps the `limit` most recent `SyntheticCheck` results of each location, and works
+// out whether the most recent check of every one of the monitor `locations` succeeded.
+// A location is matched by either the `location` or the `locationLabel` of its checks,
+// and a location which did not report a check yet is not green.
+func flattenSyntheticsMonitorResults(checks []nrdb.NRDBResult, limit int, locations []string) ([]interface{}, bool) {
+	type check struct {
+		timestamp float64
+		result    map[string]interface{}
+	}
+
+	byLocation := map[string][]check{}
+
+	for _, c := range checks {
+		location, _ := c["location"].(string)
+		locationLabel, _ := c["locationLabel"].(string)
+		result, _ := c["result"].(string)
+		duration, _ := c["duration"].(float64)
+		checkError, _ := c["error"].(string)
+		timestamp, _ := c["timestamp"].(float64)
+
+		byLocation[location] = append(byLocation[location], check{
+			timestamp: timestamp,
+			result: map[string]interface{}{
+				"location":       location,
+				"location_label": locationLabel,
+				"timestamp":      time.UnixMilli(int64(timestamp)).UTC().Format(time.RFC3339),
+				"result":         result,
+				"success":        result == "SUCCESS",
+				"duration":       duration,
+				"error":          checkError,
+			},
+		})
+	}
+
+	reported := make([]string, 0, len(byLocation))
+	for location := range byLocation {
+		reported = append(reported, location)
+	}
+	sort.Strings(reported)
+
+	results := []interface{}{}
+	latestSuccess := map[string]bool{}
+
+	for _, location := range reported {
+		locationChecks := byLocation[location]
+
+		// NRQL returns the most recent events first, but don't rely on it
+		sort.SliceStable(locationChecks, func(i, j int) bool {
+			return locationChecks[i].timestamp > locationChecks[j].timestamp
+		})
+
+		latest := locationChecks[0].result
+		latestSuccess[location] = latest["success"].(bool)
+		latestSuccess[latest["location_label"].(string)] = latest["success"].(bool)
+
+		for i, c := range locationChecks {
+			if i >= limit {
+				break
+			}
+			results = append(results, c.result)
+		}
+	}
+
+	success := len(locations) > 0
+	for _, location := range locations {
+		if !latestSuccess[location] {
+			success = false
+		}
+	}
+
+	return results, success
+}
@@ -0,0 +1,55 @@
+//go:build integration || SYNTHETICS
+// +build integration SYNTHETICS
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicSyntheticsMonitorResultsDataSource_Basic(t *testing.T) {
+	resourceName := "data.newrelic_synthetics_monitor_results.foo"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnvVars(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicSyntheticsMonitorResultsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "guid", "newrelic_synthetics_monitor.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "monitor_id"),
+					resource.TestCheckResourceAttr(resourceName, "success", "true"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.success", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicSyntheticsMonitorResultsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_monitor" "foo" {
+  name             = "%[1]s"
+  type             = "SIMPLE"
+  period           = "EVERY_MINUTE"
+  status           = "ENABLED"
+  uri              = "https://www.one.newrelic.com"
+  locations_public = ["AP_SOUTH_1"]
+}
+
+data "newrelic_synthetics_monitor_results" "foo" {
+  guid             = newrelic_synthetics_monitor.foo.id
+  wait_for_success = true
+
+  timeouts {
+    read = "10m"
+  }
+}
+`, name)
+}
@@ -0,0 +1,104 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSyntheticsMonitorResults(t *testing.T) {
+	checks := []nrdb.NRDBResult{
+		{"location": "AWS_US_EAST_1", "locationLabel": "Washington, DC, USA", "result": "FAILED", "duration": float64(1200), "error": "timeout", "timestamp": float64(1767225600000)},
+		{"location": "AWS_US_EAST_1", "locationLabel": "Washington, DC, USA", "result": "SUCCESS", "duration": float64(300), "timestamp": float64(1767225660000)},
+		{"location": "AWS_EU_WEST_1", "locationLabel": "Dublin, IE", "result": "SUCCESS", "duration": float64(450), "timestamp": float64(1767225630000)},
+	}
+
+	results, success := flattenSyntheticsMonitorResults(checks, 1, []string{"Dublin, IE", "Washington, DC, USA"})
+
+	require.True(t, success)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"location":       "AWS_EU_WEST_1",
+			"location_label": "Dublin, IE",
+			"timestamp":      "2026-01-01T00:00:30Z",
+			"result":         "SUCCESS",
+			"success":        true,
+			"duration":       float64(450),
+			"error":          "",
+		},
+		map[string]interface{}{
+			"location":       "AWS_US_EAST_1",
+			"location_label": "Washington, DC, USA",
+			"timestamp":      "2026-01-01T00:01:00Z",
+			"result":         "SUCCESS",
+			"success":        true,
+			"duration":       float64(300),
+			"error":          "",
+		},
+	}, results)
+
+	results, _ = flattenSyntheticsMonitorResults(checks, 5, []string{"Dublin, IE", "Washington, DC, USA"})
+	require.Len(t, results, 3)
+	require.Equal(t, "FAILED", results[2].(map[string]interface{})["result"])
+	require.Equal(t, "timeout", results[2].(map[string]interface{})["error"])
+}
+
+func TestFlattenSyntheticsMonitorResults_Failed(t *testing.T) {
+	checks := []nrdb.NRDBResult{
+		{"location": "AWS_US_EAST_1", "result": "SUCCESS", "timestamp": float64(1767225600000)},
+		{"location": "AWS_EU_WEST_1", "result": "FAILED", "timestamp": float64(1767225600000)},
+	}
+
+	_, success := flattenSyntheticsMonitorResults(checks, 1, []string{"AWS_EU_WEST_1", "AWS_US_EAST_1"})
+	require.False(t, success)
+
+	results, success := flattenSyntheticsMonitorResults([]nrdb.NRDBResult{}, 1, []string{"AWS_US_EAST_1"})
+	require.False(t, success)
+	require.Empty(t, results)
+}
+
+func TestFlattenSyntheticsMonitorResults_LocationsPending(t *testing.T) {
+	checks := []nrdb.NRDBResult{
+		{"location": "AWS_US_EAST_1", "locationLabel": "Washington, DC, USA", "result": "SUCCESS", "timestamp": float64(1767225600000)},
+		{"location": "123", "locationLabel": "Private", "result": "SUCCESS", "timestamp": float64(1767225600000)},
+	}
+
+	// A location which did not run a check yet keeps the monitor from being green.
+	_, success := flattenSyntheticsMonitorResults(checks, 1, []string{"123", "Dublin, IE", "Washington, DC, USA"})
+	require.False(t, success)
+
+	_, success = flattenSyntheticsMonitorResults(checks, 1, []string{"123", "Washington, DC, USA"})
+	require.True(t, success)
+
+	_, success = flattenSyntheticsMonitorResults(checks, 1, []string{})
+	require.False(t, success)
+}
+
+func TestGetSyntheticsMonitorLocations(t *testing.T) {
+	// MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfGFiYw is "1|SYNTH|PRIVATE_LOCATION|abc"
+	tags := []entities.EntityTag{
+		{Key: "publicLocation", Values: []string{"Washington, DC, USA", "Dublin, IE"}},
+		{Key: "privateLocation", Values: []string{"MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfGFiYw"}},
+		{Key: "monitorType", Values: []string{"SCRIPT_API"}},
+	}
+
+	require.Equal(t, []string{"Dublin, IE", "Washington, DC, USA", "abc"}, getSyntheticsMonitorLocations(tags))
+}
+
+func TestSyntheticsMonitorResultsQuery(t *testing.T) {
+	require.Equal(t,
+		"SELECT timestamp, result, duration, error, location, locationLabel FROM SyntheticCheck WHERE monitorId = 'abc' SINCE 60 minutes ago LIMIT MAX",
+		syntheticsMonitorResultsQuery("abc", 60, time.Time{}),
+	)
+
+	require.Equal(t,
+		"SELECT timestamp, result, duration, error, location, locationLabel FROM SyntheticCheck WHERE monitorId = 'abc' AND timestamp > 1767225600000 SINCE 15 minutes ago LIMIT MAX",
+		syntheticsMonitorResultsQuery("abc", 15, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+}
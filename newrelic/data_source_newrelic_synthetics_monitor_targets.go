@@ -0,0 +1,386 @@
+package newrelic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Nested sitemap indexes are followed up to this depth.
+const syntheticsSitemapMaxDepth = 3
+
+func dataSourceNewRelicSyntheticsMonitorTargets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsMonitorTargetsRead,
+		Schema: map[string]*schema.Schema{
+			"sitemap": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"sitemap", "hostnames"},
+				Description:  "The URL or the local path of a sitemap.xml file, or of a sitemap index, to read URIs from.",
+			},
+			"hostnames": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"sitemap", "hostnames"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "A list of hostnames to add to the discovered domains.",
+			},
+			"max_uris": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(1, 50000),
+				Description:  "The maximum number of URIs read from the sitemap.",
+			},
+			"check_certificates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to connect to every discovered domain on port 443 and read the expiry of its TLS certificate.",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "The maximum number of TLS certificates checked at the same time.",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 300),
+				Description:  "The timeout, in seconds, of each request made to read a sitemap or a TLS certificate.",
+			},
+			"uris": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The de-duplicated URIs read from the sitemap, sorted alphabetically.",
+			},
+			"domains": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The de-duplicated domains of the URIs and of `hostnames`, sorted alphabetically.",
+			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The TLS certificates of the domains, when `check_certificates` is enabled.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The domain the certificate was read from.",
+						},
+						"expires_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The expiry of the certificate, in RFC3339 format.",
+						},
+						"days_remaining": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of full days until the certificate expires. Negative when the certificate already expired.",
+						},
+						"issuer": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The issuer of the certificate.",
+						},
+						"verified": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the certificate chain is trusted and valid for the domain.",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error which prevented the certificate from being read or verified, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsMonitorTargetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Reading Synthetics monitor targets")
+
+	timeout := time.Duration(d.Get("timeout").(int)) * time.Second
+	httpClient := &http.Client{Timeout: timeout}
+
+	var sitemapURIs []string
+	sitemap := d.Get("sitemap").(string)
+	if sitemap != "" {
+		var err error
+		sitemapURIs, err = collectSyntheticsSitemapURIs(ctx, httpClient, sitemap, d.Get("max_uris").(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	uris, domains := buildSyntheticsMonitorTargets(sitemapURIs, expandStringSlice(d.Get("hostnames").([]interface{})))
+
+	certificates := []interface{}{}
+	if d.Get("check_certificates").(bool) {
+		certificates = checkSyntheticsCertificates(ctx, domains, d.Get("concurrency").(int), timeout, time.Now())
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(sitemap+"|"+strings.Join(domains, ","))))
+
+	if err := d.Set("uris", uris); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("domains", domains); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("certificates", certificates); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+type syntheticsSitemapLocation struct {
+	Loc string `xml:"loc"`
+}
+
+// syntheticsSitemap is either a `urlset` listing pages, or a `sitemapindex` listing other sitemaps.
+type syntheticsSitemap struct {
+	URLs     []syntheticsSitemapLocation `xml:"url"`
+	Sitemaps []syntheticsSitemapLocation `xml:"sitemap"`
+}
+
+// Reads a sitemap from a URL or a local file, decompressing it when gzipped.
+func readSyntheticsSitemap(ctx context.Context, client *http.Client, location string) ([]byte, error) {
+	var data []byte
+
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d reading sitemap %s", resp.StatusCode, location)
+		}
+
+		data, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		data, err = os.ReadFile(location)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+
+		return io.ReadAll(reader)
+	}
+
+	return data, nil
+}
+
+func parseSyntheticsSitemap(data []byte) (*syntheticsSitemap, error) {
+	var sitemap syntheticsSitemap
+	if err := xml.Unmarshal(data, &sitemap); err != nil {
+		return nil, fmt.Errorf("invalid sitemap: %w", err)
+	}
+
+	return &sitemap, nil
+}
+
+// Collects the page URIs of a sitemap, following nested sitemap indexes, until `maxURIs` are found.
+func collectSyntheticsSitemapURIs(ctx context.Context, client *http.Client, location string, maxURIs int) ([]string, error) {
+	uris := []string{}
+	visited := map[string]bool{}
+	pending := []string{location}
+
+	for depth := 0; len(pending) > 0 && depth <= syntheticsSitemapMaxDepth; depth++ {
+		var next []string
+
+		for _, loc := range pending {
+			if visited[loc] {
+				continue
+			}
+			visited[loc] = true
+
+			data, err := readSyntheticsSitemap(ctx, client, loc)
+			if err != nil {
+				return nil, err
+			}
+
+			sitemap, err := parseSyntheticsSitemap(data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", loc, err)
+			}
+
+			for _, u := range sitemap.URLs {
+				if len(uris) >= maxURIs {
+					return uris, nil
+				}
+				uris = append(uris, strings.TrimSpace(u.Loc))
+			}
+
+			for _, s := range sitemap.Sitemaps {
+				next = append(next, strings.TrimSpace(s.Loc))
+			}
+		}
+
+		pending = next
+	}
+
+	return uris, nil
+}
+
+// De-duplicates and sorts URIs, and derives the domains to monitor from them and from the given hostnames.
+func buildSyntheticsMonitorTargets(rawURIs []string, hostnames []string) ([]string, []string) {
+	uriSet := map[string]bool{}
+	domainSet := map[string]bool{}
+
+	for _, raw := range rawURIs {
+		u, err := url.Parse(strings.TrimSpace(raw))
+		if err != nil || u.Host == "" {
+			log.Printf("[WARN] Ignoring invalid URI %q", raw)
+			continue
+		}
+
+		u.Fragment = ""
+		u.Host = strings.ToLower(u.Host)
+		u.Scheme = strings.ToLower(u.Scheme)
+
+		uriSet[u.String()] = true
+		domainSet[u.Hostname()] = true
+	}
+
+	for _, h := range hostnames {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if h != "" {
+			domainSet[h] = true
+		}
+	}
+
+	uris := make([]string, 0, len(uriSet))
+	for u := range uriSet {
+		uris = append(uris, u)
+	}
+	sort.Strings(uris)
+
+	domains := make([]string, 0, len(domainSet))
+	for d := range domainSet {
+		domains = append(domains, d)
+	}
+	sort.Strings(domains)
+
+	return uris, domains
+}
+
+func checkSyntheticsCertificates(ctx context.Context, domains []string, concurrency int, timeout time.Duration, now time.Time) []interface{} {
+	certificates := make(map[string]map[string]interface{}, len(domains))
+	for _, domain := range domains {
+		certificates[domain] = map[string]interface{}{"domain": domain}
+	}
+
+	// every goroutine only writes to the map it was handed, which was created upfront
+	errs := runWithBoundedConcurrency(ctx, domains, concurrency, func(ctx context.Context, domain string) error {
+		return checkSyntheticsCertificate(ctx, domain, timeout, now, certificates[domain])
+	})
+
+	out := make([]interface{}, 0, len(domains))
+	for _, domain := range domains {
+		if err, ok := errs[domain]; ok {
+			certificates[domain]["error"] = err.Error()
+		}
+		out = append(out, certificates[domain])
+	}
+
+	return out
+}
+
+// Reads the TLS certificate of a domain, given as `host` or `host:port`, into `certificate`.
+// The chain is verified separately from the handshake, so the expiry of untrusted or
+// expired certificates can still be reported.
+func checkSyntheticsCertificate(ctx context.Context, domain string, timeout time.Duration, now time.Time, certificate map[string]interface{}) error {
+	host, port, err := net.SplitHostPort(domain)
+	if err != nil {
+		host, port = domain, "443"
+	}
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: timeout},
+		Config: &tls.Config{
+			ServerName:         host,
+			InsecureSkipVerify: true, // #nosec G402 -- verified below, once the expiry is known
+		},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	peerCertificates := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(peerCertificates) == 0 {
+		return fmt.Errorf("no certificate presented by %s", domain)
+	}
+
+	leaf := peerCertificates[0]
+	certificate["expires_at"] = leaf.NotAfter.UTC().Format(time.RFC3339)
+	certificate["days_remaining"] = int(math.Floor(leaf.NotAfter.Sub(now).Hours() / 24))
+	certificate["issuer"] = leaf.Issuer.String()
+
+	intermediates := x509.NewCertPool()
+	for _, c := range peerCertificates[1:] {
+		intermediates.AddCert(c)
+	}
+
+	_, err = leaf.Verify(x509.VerifyOptions{
+		DNSName:       host,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	})
+	certificate["verified"] = err == nil
+
+	return err
+}
@@ -0,0 +1,113 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectSyntheticsSitemapURIs(t *testing.T) {
+	var serverURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sitemap_index.xml":
+			fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <sitemap><loc>%[1]s/pages.xml</loc></sitemap>
+  <sitemap><loc>%[1]s/blog.xml.gz</loc></sitemap>
+</sitemapindex>`, serverURL)
+		case "/pages.xml":
+			fmt.Fprint(w, `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url><loc>https://www.example.com/</loc></url>
+  <url><loc> https://www.example.com/about </loc></url>
+</urlset>`)
+		case "/blog.xml.gz":
+			var buf bytes.Buffer
+			gz := gzip.NewWriter(&buf)
+			_, _ = gz.Write([]byte(`<urlset><url><loc>https://Blog.Example.com/post#comments</loc></url></urlset>`))
+			_ = gz.Close()
+			_, _ = w.Write(buf.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	serverURL = server.URL
+
+	uris, err := collectSyntheticsSitemapURIs(context.Background(), server.Client(), server.URL+"/sitemap_index.xml", 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"https://www.example.com/",
+		"https://www.example.com/about",
+		"https://Blog.Example.com/post#comments",
+	}, uris)
+
+	uris, err = collectSyntheticsSitemapURIs(context.Background(), server.Client(), server.URL+"/sitemap_index.xml", 1)
+	require.NoError(t, err)
+	require.Len(t, uris, 1)
+
+	_, err = collectSyntheticsSitemapURIs(context.Background(), server.Client(), server.URL+"/missing.xml", 100)
+	require.Error(t, err)
+}
+
+func TestCollectSyntheticsSitemapURIs_LocalFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sitemap.xml")
+	require.NoError(t, os.WriteFile(path, []byte(`<urlset><url><loc>https://www.example.com/</loc></url></urlset>`), 0600))
+
+	uris, err := collectSyntheticsSitemapURIs(context.Background(), http.DefaultClient, path, 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://www.example.com/"}, uris)
+}
+
+func TestBuildSyntheticsMonitorTargets(t *testing.T) {
+	uris, domains := buildSyntheticsMonitorTargets(
+		[]string{
+			"https://www.example.com/about",
+			"https://WWW.example.com/about#team",
+			"https://Blog.Example.com/post",
+			"not a uri",
+		},
+		[]string{"api.example.com", " www.example.com ", ""},
+	)
+
+	require.Equal(t, []string{"https://blog.example.com/post", "https://www.example.com/about"}, uris)
+	require.Equal(t, []string{"api.example.com", "blog.example.com", "www.example.com"}, domains)
+}
+
+func TestCheckSyntheticsCertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	domain := strings.TrimPrefix(server.URL, "https://")
+	now := time.Now()
+
+	certificates := checkSyntheticsCertificates(context.Background(), []string{domain, "127.0.0.1:1"}, 2, 5*time.Second, now)
+	require.Len(t, certificates, 2)
+
+	// The test server's certificate is self-signed, so its expiry is read but it is not verified.
+	certificate := certificates[0].(map[string]interface{})
+	leaf := server.Certificate()
+	require.Equal(t, domain, certificate["domain"])
+	require.Equal(t, leaf.NotAfter.UTC().Format(time.RFC3339), certificate["expires_at"])
+	require.Equal(t, int(leaf.NotAfter.Sub(now).Hours()/24), certificate["days_remaining"])
+	require.Equal(t, false, certificate["verified"])
+	require.NotEmpty(t, certificate["error"])
+
+	unreachable := certificates[1].(map[string]interface{})
+	require.Equal(t, "127.0.0.1:1", unreachable["domain"])
+	require.NotContains(t, unreachable, "expires_at")
+	require.NotEmpty(t, unreachable["error"])
+}
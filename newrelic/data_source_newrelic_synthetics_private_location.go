@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
 )
 
@@ -48,35 +49,11 @@ func dataSourceNewRelicSyntheticsPrivateLocationRead(ctx context.Context, d *sch
 		return diag.FromErr(errors.New("`name` is required"))
 	}
 
-	query := fmt.Sprintf("domain = 'SYNTH' AND type = 'PRIVATE_LOCATION' AND name = '%s'", name)
-	entitySearch, err := client.Entities.GetEntitySearchByQueryWithContext(
-		ctx,
-		entities.EntitySearchOptions{},
-		query,
-		[]entities.EntitySearchSortCriteria{},
-	)
-
+	location, err := findSyntheticsPrivateLocationByName(ctx, client, accountID, name.(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	privateLocations := entitySearch.Results.Entities
-	var location *entities.GenericEntityOutline
-	for _, l := range privateLocations {
-		loc := l.(*entities.GenericEntityOutline)
-
-		// It's possible to have multiple private locations with the same name.
-		// Return the first matching private location.
-		if loc.AccountID == accountID && loc.Name == name {
-			location = loc
-			break
-		}
-	}
-
-	if location == nil {
-		return diag.FromErr(fmt.Errorf("no matches found for private location with name '%s'", name))
-	}
-
 	d.SetId(string(location.GUID))
 
 	err = d.Set("name", location.Name)
@@ -109,3 +86,31 @@ func fetchPrivateLocationKey(tagList []entities.EntityTag) []string {
 	}
 	return key
 }
+
+// Looks up a Synthetics private location entity by its name within the given account.
+func findSyntheticsPrivateLocationByName(ctx context.Context, client *newrelic.NewRelic, accountID int, name string) (*entities.GenericEntityOutline, error) {
+	query := fmt.Sprintf("domain = 'SYNTH' AND type = 'PRIVATE_LOCATION' AND name = '%s'", name)
+	entitySearch, err := client.Entities.GetEntitySearchByQueryWithContext(
+		ctx,
+		entities.EntitySearchOptions{},
+		query,
+		[]entities.EntitySearchSortCriteria{},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	privateLocations := entitySearch.Results.Entities
+	for _, l := range privateLocations {
+		loc := l.(*entities.GenericEntityOutline)
+
+		// It's possible to have multiple private locations with the same name.
+		// Return the first matching private location.
+		if loc.AccountID == accountID && loc.Name == name {
+			return loc, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no matches found for private location with name '%s'", name)
+}
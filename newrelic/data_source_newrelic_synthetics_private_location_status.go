@@ -0,0 +1,258 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+)
+
+func dataSourceNewRelicSyntheticsPrivateLocationStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsPrivateLocationStatusRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the account in New Relic.",
+			},
+			"guid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"guid", "name"},
+				Description:  "The GUID of the Synthetics monitor private location.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"guid", "name"},
+				Description:  "The name of the Synthetics monitor private location.",
+			},
+			"heartbeat_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 1440),
+				Description:  "The number of minutes without a heartbeat after which a minion or job manager is considered unhealthy.",
+			},
+			"location_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the private location, as reported in Synthetics events.",
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether at least one minion or job manager of the private location sent a heartbeat within `heartbeat_timeout`.",
+			},
+			"last_heartbeat": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the most recent heartbeat received from any minion or job manager of the private location, in RFC3339 format.",
+			},
+			"checks_pending": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of checks queued for the private location, waiting to be picked up by a minion or job manager.",
+			},
+			"minions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The minions and job managers which reported to the private location within the last day.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the minion or job manager.",
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hostname of the minion or job manager.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the minion or job manager.",
+						},
+						"last_heartbeat": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the last heartbeat of the minion or job manager, in RFC3339 format.",
+						},
+						"healthy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the minion or job manager sent a heartbeat within `heartbeat_timeout`.",
+						},
+					},
+				},
+			},
+			"monitors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Synthetics monitors assigned to the private location.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The GUID of the monitor.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the monitor.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsPrivateLocationStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading Synthetics private location status")
+
+	guid := d.Get("guid").(string)
+	if name, ok := d.GetOk("name"); ok {
+		location, err := findSyntheticsPrivateLocationByName(ctx, client, accountID, name.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		guid = string(location.GUID)
+	}
+
+	locationID, err := getPrivateLocationID(guid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	minionsQuery := fmt.Sprintf(
+		"SELECT latest(timestamp), latest(minionHostname), latest(minionVersion) FROM SyntheticsPrivateMinion WHERE minionLocation = '%s' FACET minionId SINCE 1 day ago LIMIT MAX",
+		escapeSingleQuote(locationID),
+	)
+	minionsResp, err := client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(minionsQuery))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statusQuery := fmt.Sprintf(
+		"SELECT latest(checksPending) FROM SyntheticsPrivateLocationStatus WHERE name = '%s' SINCE 1 day ago",
+		escapeSingleQuote(locationID),
+	)
+	statusResp, err := client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(statusQuery))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	monitorsQuery := fmt.Sprintf("domain = 'SYNTH' AND type = 'MONITOR' AND tags.privateLocation = '%s'", guid)
+	monitors, err := searchAllEntities(ctx, client, monitorsQuery)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout := time.Duration(d.Get("heartbeat_timeout").(int)) * time.Minute
+	minions, lastHeartbeat, healthy := flattenSyntheticsPrivateLocationMinions(minionsResp.Results, time.Now(), timeout)
+
+	d.SetId(guid)
+	_ = d.Set("guid", guid)
+	_ = d.Set("location_id", locationID)
+	_ = d.Set("healthy", healthy)
+	_ = d.Set("checks_pending", flattenSyntheticsPrivateLocationChecksPending(statusResp.Results))
+	_ = d.Set("monitors", flattenSyntheticsPrivateLocationMonitors(monitors))
+
+	if !lastHeartbeat.IsZero() {
+		_ = d.Set("last_heartbeat", lastHeartbeat.UTC().Format(time.RFC3339))
+	}
+
+	if err := d.Set("minions", minions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Converts the faceted `SyntheticsPrivateMinion` results into the `minions` attribute, and
+// works out the most recent heartbeat of the location and whether any minion is healthy.
+func flattenSyntheticsPrivateLocationMinions(results []nrdb.NRDBResult, now time.Time, timeout time.Duration) ([]interface{}, time.Time, bool) {
+	var lastHeartbeat time.Time
+	healthy := false
+	minions := make([]interface{}, 0, len(results))
+
+	for _, r := range results {
+		minionID, _ := r["facet"].(string)
+		if minionID == "" {
+			continue
+		}
+
+		hostname, _ := r["latest.minionHostname"].(string)
+		version, _ := r["latest.minionVersion"].(string)
+
+		minion := map[string]interface{}{
+			"id":       minionID,
+			"hostname": hostname,
+			"version":  version,
+			"healthy":  false,
+		}
+
+		if timestamp, ok := r["latest.timestamp"].(float64); ok {
+			heartbeat := time.UnixMilli(int64(timestamp))
+			minion["last_heartbeat"] = heartbeat.UTC().Format(time.RFC3339)
+			minion["healthy"] = now.Sub(heartbeat) <= timeout
+
+			if heartbeat.After(lastHeartbeat) {
+				lastHeartbeat = heartbeat
+			}
+		}
+
+		if minion["healthy"].(bool) {
+			healthy = true
+		}
+
+		minions = append(minions, minion)
+	}
+
+	sort.Slice(minions, func(i, j int) bool {
+		return minions[i].(map[string]interface{})["id"].(string) < minions[j].(map[string]interface{})["id"].(string)
+	})
+
+	return minions, lastHeartbeat, healthy
+}
+
+func flattenSyntheticsPrivateLocationChecksPending(results []nrdb.NRDBResult) int {
+	for _, r := range results {
+		if pending, ok := r["latest.checksPending"].(float64); ok {
+			return int(pending)
+		}
+	}
+
+	return 0
+}
+
+func flattenSyntheticsPrivateLocationMonitors(outlines []entities.EntityOutlineInterface) []interface{} {
+	monitors := make([]interface{}, 0, len(outlines))
+
+	for _, o := range outlines {
+		monitors = append(monitors, map[string]interface{}{
+			"guid": string(o.GetGUID()),
+			"name": o.GetName(),
+		})
+	}
+
+	return monitors
+}
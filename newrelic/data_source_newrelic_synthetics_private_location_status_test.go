@@ -0,0 +1,47 @@
+//go:build integration || SYNTHETICS
+// +build integration SYNTHETICS
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicSyntheticsPrivateLocationStatusDataSource_Basic(t *testing.T) {
+	resourceName := "data.newrelic_synthetics_private_location_status.foo"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnvVars(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicSyntheticsPrivateLocationStatusDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "guid", "newrelic_synthetics_private_location.foo", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "location_id"),
+					// A newly created private location has no minions reporting to it.
+					resource.TestCheckResourceAttr(resourceName, "healthy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "minions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicSyntheticsPrivateLocationStatusDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_private_location" "foo" {
+  name                      = "%[1]s"
+  description               = "created via TF integration tests"
+  verified_script_execution = false
+}
+
+data "newrelic_synthetics_private_location_status" "foo" {
+  guid = newrelic_synthetics_private_location.foo.id
+}
+`, name)
+}
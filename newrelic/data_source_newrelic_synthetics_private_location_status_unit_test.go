@@ -0,0 +1,77 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSyntheticsPrivateLocationMinions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	results := []nrdb.NRDBResult{
+		{
+			"facet":                 "minion-b",
+			"latest.timestamp":      float64(now.Add(-30 * time.Minute).UnixMilli()),
+			"latest.minionHostname": "host-b",
+			"latest.minionVersion":  "3.0.1",
+		},
+		{
+			"facet":                 "minion-a",
+			"latest.timestamp":      float64(now.Add(-2 * time.Minute).UnixMilli()),
+			"latest.minionHostname": "host-a",
+			"latest.minionVersion":  "3.0.2",
+		},
+	}
+
+	minions, lastHeartbeat, healthy := flattenSyntheticsPrivateLocationMinions(results, now, 5*time.Minute)
+
+	require.True(t, healthy)
+	require.Equal(t, now.Add(-2*time.Minute), lastHeartbeat.UTC())
+	require.Len(t, minions, 2)
+
+	first := minions[0].(map[string]interface{})
+	require.Equal(t, "minion-a", first["id"])
+	require.Equal(t, "host-a", first["hostname"])
+	require.Equal(t, true, first["healthy"])
+	require.Equal(t, "2024-05-01T11:58:00Z", first["last_heartbeat"])
+
+	second := minions[1].(map[string]interface{})
+	require.Equal(t, "minion-b", second["id"])
+	require.Equal(t, false, second["healthy"])
+}
+
+func TestFlattenSyntheticsPrivateLocationMinions_NoHeartbeats(t *testing.T) {
+	t.Parallel()
+
+	minions, lastHeartbeat, healthy := flattenSyntheticsPrivateLocationMinions([]nrdb.NRDBResult{}, time.Now(), 5*time.Minute)
+
+	require.False(t, healthy)
+	require.True(t, lastHeartbeat.IsZero())
+	require.Empty(t, minions)
+}
+
+func TestFlattenSyntheticsPrivateLocationChecksPending(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 42, flattenSyntheticsPrivateLocationChecksPending([]nrdb.NRDBResult{{"latest.checksPending": float64(42)}}))
+	require.Equal(t, 0, flattenSyntheticsPrivateLocationChecksPending([]nrdb.NRDBResult{{"latest.checksPending": nil}}))
+}
+
+func TestGetPrivateLocationID(t *testing.T) {
+	t.Parallel()
+
+	// "1|SYNTH|PRIVATE_LOCATION|abc-123"
+	id, err := getPrivateLocationID("MXxTWU5USHxQUklWQVRFX0xPQ0FUSU9OfGFiYy0xMjM")
+	require.NoError(t, err)
+	require.Equal(t, "abc-123", id)
+
+	_, err = getPrivateLocationID("bm90LWEtZ3VpZA")
+	require.EqualError(t, err, "invalid private location GUID 'bm90LWEtZ3VpZA'")
+}
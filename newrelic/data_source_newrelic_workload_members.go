@@ -0,0 +1,311 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+)
+
+func dataSourceNewRelicWorkloadMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicWorkloadMembersRead,
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				Description:   "The GUID of a workload whose definition is resolved.",
+				AtLeastOneOf:  []string{"guid", "entity_guids", "entity_search_query"},
+				ConflictsWith: []string{"entity_guids", "entity_search_query"},
+			},
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The New Relic account ID of the workload.",
+			},
+			"entity_guids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Description:  "A list of entity GUIDs manually assigned to the workload.",
+				AtLeastOneOf: []string{"guid", "entity_guids", "entity_search_query"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"entity_search_query": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Description:  "A list of search queries that define a dynamic workload.",
+				AtLeastOneOf: []string{"guid", "entity_guids", "entity_search_query"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Description:  "A valid entity search query.",
+						},
+					},
+				},
+			},
+			"scope_account_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "A list of account IDs the entities of the search queries are retrieved from. Defaults to the scope accounts of the workload, or to the account ID.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"entities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The member entities of the workload, ordered by GUID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"matched_by": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The entity search queries the entity matches, and `entity_guids` when it is manually assigned.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"guids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The GUIDs of the member entities, ordered.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// A member entity of a workload, with how it got there.
+type workloadMember struct {
+	guid       string
+	name       string
+	entityType string
+	domain     string
+	accountID  int
+	matchedBy  []string
+}
+
+const workloadMembersManuallyAssigned = "entity_guids"
+
+func dataSourceNewRelicWorkloadMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	guids := []string{}
+	for _, g := range d.Get("entity_guids").(*schema.Set).List() {
+		guids = append(guids, g.(string))
+	}
+
+	queries := []string{}
+	for _, q := range d.Get("entity_search_query").([]interface{}) {
+		queries = append(queries, q.(map[string]interface{})["query"].(string))
+	}
+
+	scope := expandIntList(d.Get("scope_account_ids").(*schema.Set).List())
+
+	if workloadGUID, ok := d.GetOk("guid"); ok {
+		log.Printf("[INFO] Reading the definition of New Relic workload %s", workloadGUID)
+
+		workload, err := client.Workloads.GetCollectionWithContext(ctx, accountID, common.EntityGUID(workloadGUID.(string)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if workload == nil {
+			return diag.Errorf("workload %s not found in account %d", workloadGUID, accountID)
+		}
+
+		for _, e := range workload.Entities {
+			guids = append(guids, string(e.GUID))
+		}
+		for _, q := range workload.EntitySearchQueries {
+			queries = append(queries, q.Query)
+		}
+		if len(scope) == 0 {
+			scope = workload.ScopeAccounts.AccountIDs
+		}
+	}
+
+	if len(scope) == 0 {
+		scope = []int{accountID}
+	}
+
+	log.Printf("[INFO] Resolving the members of a New Relic workload")
+
+	assigned := []workloadMember{}
+	if len(guids) > 0 {
+		entityGUIDs := make([]common.EntityGUID, 0, len(guids))
+		for _, g := range guids {
+			entityGUIDs = append(entityGUIDs, common.EntityGUID(g))
+		}
+
+		byGUID := map[string]workloadMember{}
+		for start := 0; start < len(entityGUIDs); start += entitiesBatchSize {
+			end := start + entitiesBatchSize
+			if end > len(entityGUIDs) {
+				end = len(entityGUIDs)
+			}
+
+			found, err := client.Entities.GetEntitiesWithContext(ctx, entityGUIDs[start:end])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if found == nil {
+				continue
+			}
+
+			for _, e := range *found {
+				byGUID[string(e.GetGUID())] = workloadMember{
+					guid:       string(e.GetGUID()),
+					name:       e.GetName(),
+					entityType: e.GetType(),
+					domain:     e.GetDomain(),
+					accountID:  e.GetAccountID(),
+				}
+			}
+		}
+
+		// Entities which no longer exist are still members, as far as the workload knows.
+		for _, g := range guids {
+			if m, ok := byGUID[g]; ok {
+				assigned = append(assigned, m)
+			} else {
+				assigned = append(assigned, workloadMember{guid: g})
+			}
+		}
+	}
+
+	matched := map[string][]workloadMember{}
+	for _, q := range queries {
+		found, err := searchAllEntities(ctx, client, workloadMembersSearchQuery(q, scope))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		members := []workloadMember{}
+		for _, e := range found {
+			members = append(members, workloadMember{
+				guid:       string(e.GetGUID()),
+				name:       e.GetName(),
+				entityType: e.GetType(),
+				domain:     e.GetDomain(),
+				accountID:  e.GetAccountID(),
+			})
+		}
+		matched[q] = members
+	}
+
+	members := resolveWorkloadMembers(assigned, queries, matched)
+
+	flattened := make([]interface{}, 0, len(members))
+	memberGUIDs := make([]string, 0, len(members))
+	for _, m := range members {
+		flattened = append(flattened, map[string]interface{}{
+			"guid":       m.guid,
+			"name":       m.name,
+			"type":       m.entityType,
+			"domain":     m.domain,
+			"account_id": m.accountID,
+			"matched_by": m.matchedBy,
+		})
+		memberGUIDs = append(memberGUIDs, m.guid)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(memberGUIDs, ","))))
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("scope_account_ids", scope)
+	_ = d.Set("guids", memberGUIDs)
+
+	if err := d.Set("entities", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Restricts an entity search query of a workload to the entities of its scope accounts.
+func workloadMembersSearchQuery(query string, scope []int) string {
+	accountIDs := make([]string, 0, len(scope))
+	for _, a := range scope {
+		accountIDs = append(accountIDs, strconv.Itoa(a))
+	}
+
+	return fmt.Sprintf("(%s) AND accountId IN (%s)", query, strings.Join(accountIDs, ", "))
+}
+
+// Merges the manually assigned entities of a workload with the entities its search
+// queries match, ordered by GUID. An entity matched more than once is listed once, with
+// everything it is matched by.
+func resolveWorkloadMembers(assigned []workloadMember, queries []string, matched map[string][]workloadMember) []workloadMember {
+	members := map[string]*workloadMember{}
+	add := func(m workloadMember, matchedBy string) {
+		member, ok := members[m.guid]
+		if !ok {
+			m.matchedBy = []string{}
+			member = &m
+			members[m.guid] = member
+		}
+
+		for _, by := range member.matchedBy {
+			if by == matchedBy {
+				return
+			}
+		}
+		member.matchedBy = append(member.matchedBy, matchedBy)
+	}
+
+	for _, m := range assigned {
+		add(m, workloadMembersManuallyAssigned)
+	}
+
+	for _, q := range queries {
+		for _, m := range matched[q] {
+			add(m, q)
+		}
+	}
+
+	guids := make([]string, 0, len(members))
+	for guid := range members {
+		guids = append(guids, guid)
+	}
+	sort.Strings(guids)
+
+	resolved := make([]workloadMember, 0, len(guids))
+	for _, guid := range guids {
+		resolved = append(resolved, *members[guid])
+	}
+
+	return resolved
+}
@@ -0,0 +1,59 @@
+//go:build integration || WORKLOADS
+// +build integration WORKLOADS
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicWorkloadMembersDataSource_Basic(t *testing.T) {
+	resourceName := "data.newrelic_workload_members.members"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicWorkloadMembersDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entities.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "entities.0.guid", "data.newrelic_entity.app", "guid"),
+					resource.TestCheckResourceAttr(resourceName, "entities.0.matched_by.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicWorkloadMembersDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+data "newrelic_entity" "app" {
+	name = "%[3]s"
+	domain = "APM"
+	type = "APPLICATION"
+}
+
+resource "newrelic_workload" "foo" {
+	name = "%[2]s"
+	account_id = %[1]d
+
+	entity_guids = [data.newrelic_entity.app.guid]
+
+	entity_search_query {
+		query = "name = '%[3]s' AND domain = 'APM'"
+	}
+
+	scope_account_ids =  [%[1]d]
+}
+
+data "newrelic_workload_members" "members" {
+	account_id = %[1]d
+	guid       = newrelic_workload.foo.guid
+}
+`, testAccountID, name, testAccExpectedApplicationName)
+}
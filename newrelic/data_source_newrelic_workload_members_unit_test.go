@@ -0,0 +1,50 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveWorkloadMembers(t *testing.T) {
+	assigned := []workloadMember{
+		{guid: "c", name: "checkout", entityType: "APPLICATION", domain: "APM", accountID: 3},
+		{guid: "z"},
+	}
+
+	queries := []string{"type = 'APPLICATION'", "name like 'pay%'"}
+	matched := map[string][]workloadMember{
+		"type = 'APPLICATION'": {
+			{guid: "p", name: "payment", entityType: "APPLICATION", domain: "APM", accountID: 1},
+		},
+		"name like 'pay%'": {
+			{guid: "p", name: "payment", entityType: "APPLICATION", domain: "APM", accountID: 1},
+			{guid: "h", name: "payment-host", entityType: "HOST", domain: "INFRA", accountID: 1},
+		},
+	}
+
+	members := resolveWorkloadMembers(assigned, queries, matched)
+
+	guids := []string{}
+	for _, m := range members {
+		guids = append(guids, m.guid)
+	}
+
+	require.Equal(t, []string{"c", "h", "p", "z"}, guids)
+	require.Equal(t, []string{workloadMembersManuallyAssigned}, members[0].matchedBy)
+	require.Equal(t, "checkout", members[0].name)
+	require.Equal(t, []string{"name like 'pay%'"}, members[1].matchedBy)
+	require.Equal(t, "HOST", members[1].entityType)
+	require.Equal(t, []string{"type = 'APPLICATION'", "name like 'pay%'"}, members[2].matchedBy)
+	require.Equal(t, []string{workloadMembersManuallyAssigned}, members[3].matchedBy)
+
+	require.Empty(t, resolveWorkloadMembers(nil, queries, map[string][]workloadMember{}))
+}
+
+func TestWorkloadMembersSearchQuery(t *testing.T) {
+	require.Equal(t, "(type = 'APPLICATION' OR type = 'HOST') AND accountId IN (1, 2)", workloadMembersSearchQuery("type = 'APPLICATION' OR type = 'HOST'", []int{1, 2}))
+	require.Equal(t, "(name like 'pay%') AND accountId IN (3)", workloadMembersSearchQuery("name like 'pay%'", []int{3}))
+}
@@ -0,0 +1,160 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNewRelicWorkloadStatusPreview() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicWorkloadStatusPreviewRead,
+		Schema: map[string]*schema.Schema{
+			"entity": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "A member entity of the workload, with its current alert severity.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Description:  "The GUID of the entity.",
+						},
+						"alert_severity": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(listValidWorkloadEntityAlertSeverities(), false),
+							Description:  "The alert severity of the entity.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The type of the entity, which the remaining entities are grouped by with the ENTITY_TYPE grouping.",
+						},
+					},
+				},
+			},
+			"status_config_automatic": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The automatic status configuration of the workload, as the one of newrelic_workload.",
+				Elem:        WorkloadStatusConfigAutomaticSchemaElem(),
+			},
+			"status_config_static": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The static status configuration of the workload, as the one of newrelic_workload.",
+				Elem:        WorkloadStatusConfigStaticSchemaElem(),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resulting status of the workload.",
+			},
+			"status_source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Where the status of the workload comes from: STATIC, ROLLUP_RULE or UNKNOWN.",
+			},
+			"rule_statuses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status each rule rolls up.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_guids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"remaining_entities_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status the remaining entities rule rolls up.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicWorkloadStatusPreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Previewing the status of a New Relic workload")
+
+	automatic := expandWorkloadStatusConfigAutomaticInput(d.Get("status_config_automatic").(*schema.Set).List())
+	static := expandWorkloadStatusConfigStaticInput(d.Get("status_config_static").(*schema.Set).List())
+
+	members := []workloadStatusEntity{}
+	for _, e := range d.Get("entity").([]interface{}) {
+		entity := e.(map[string]interface{})
+		members = append(members, workloadStatusEntity{
+			guid:       entity["guid"].(string),
+			entityType: entity["type"].(string),
+			status:     workloadEntityStatus(entity["alert_severity"].(string)),
+		})
+	}
+
+	// The entities of the search queries of the rules are resolved, while their status is
+	// the one of the `entity` blocks.
+	ruleMembers := workloadStatusRuleGUIDs(automatic)
+	for i, rule := range automatic.Rules {
+		for _, q := range rule.EntitySearchQueries {
+			matched, err := searchAllEntities(ctx, client, q.Query)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			for _, e := range matched {
+				ruleMembers[i] = append(ruleMembers[i], string(e.GetGUID()))
+			}
+		}
+	}
+
+	// An entity matched by the queries of more than one rule overlaps as well.
+	diags := workloadStatusRulesOverlapWarnings(automatic, ruleMembers)
+
+	preview := previewWorkloadStatus(automatic, static, members, ruleMembers)
+
+	ruleStatuses := make([]interface{}, 0, len(preview.ruleStatuses))
+	for i, status := range preview.ruleStatuses {
+		ruleStatuses = append(ruleStatuses, map[string]interface{}{
+			"entity_guids": ruleMembers[i],
+			"status":       string(status),
+		})
+	}
+
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, fmt.Sprintf("%s=%s", m.guid, m.status))
+	}
+	sort.Strings(ids)
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s:%s:%s", strings.Join(ids, ","), preview.status, preview.source))))
+	_ = d.Set("status", string(preview.status))
+	_ = d.Set("status_source", string(preview.source))
+	_ = d.Set("remaining_entities_status", string(preview.remainingStatus))
+
+	if err := d.Set("rule_statuses", ruleStatuses); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,87 @@
+//go:build integration || WORKLOADS
+// +build integration WORKLOADS
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicWorkloadStatusPreviewDataSource_Basic(t *testing.T) {
+	resourceName := "data.newrelic_workload_status_preview.preview"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicWorkloadStatusPreviewDataSourceConfig("c"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "DEGRADED"),
+					resource.TestCheckResourceAttr(resourceName, "status_source", "ROLLUP_RULE"),
+					resource.TestCheckResourceAttr(resourceName, "rule_statuses.0.status", "OPERATIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_entities_status", "DEGRADED"),
+				),
+			},
+			// Test: An entity in two rules is counted by both, with a warning
+			{
+				Config: testAccNewRelicWorkloadStatusPreviewDataSourceConfig("a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "DISRUPTED"),
+					resource.TestCheckResourceAttr(resourceName, "rule_statuses.1.status", "DISRUPTED"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_entities_status", "DEGRADED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicWorkloadStatusPreviewDataSourceConfig(secondRuleGUID string) string {
+	return `
+data "newrelic_workload_status_preview" "preview" {
+	entity {
+		guid           = "a"
+		alert_severity = "CRITICAL"
+	}
+
+	entity {
+		guid           = "b"
+		alert_severity = "NOT_ALERTING"
+	}
+
+	entity {
+		guid           = "c"
+		alert_severity = "NOT_ALERTING"
+	}
+
+	entity {
+		guid           = "d"
+		alert_severity = "WARNING"
+	}
+
+	status_config_automatic {
+		enabled = true
+		remaining_entities_rule {
+			remaining_entities_rule_rollup {
+				strategy = "WORST_STATUS_WINS"
+				group_by = "NONE"
+			}
+		}
+		rule {
+			entity_guids = ["a", "b"]
+			rollup {
+				strategy = "BEST_STATUS_WINS"
+			}
+		}
+		rule {
+			entity_guids = ["` + secondRuleGUID + `"]
+			rollup {
+				strategy = "WORST_STATUS_WINS"
+			}
+		}
+	}
+}
+`
+}
@@ -10,10 +10,14 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/contextkeys"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
 )
 
 // Selects the proper accountID for usage within a resource. An account ID provided
@@ -207,3 +211,279 @@ func convertInterfaceToStringSlice(v interface{}) []string {
 	}
 	return result
 }
+
+// The entities API returns at most 25 entities per request.
+const entitiesBatchSize = 25
+
+// Invokes fn once per key, with no more than `limit` invocations in flight at a
+// time. A failure for one key does not stop the remaining keys from being
+// processed; the returned map holds the error for every key that failed.
+func runWithBoundedConcurrency(ctx context.Context, keys []string, limit int, fn func(ctx context.Context, key string) error) map[string]error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := map[string]error{}
+	sem := make(chan struct{}, limit)
+
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			mu.Lock()
+			errs[key] = err
+			mu.Unlock()
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, key); err != nil {
+				mu.Lock()
+				errs[key] = err
+				mu.Unlock()
+			}
+		}(key)
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// The attributes of a resource managing a set of remote objects, such as monitors or
+// linked accounts, from a map of names to a value each object is created from.
+type keyedSet struct {
+	// The map of names to the value of each object, e.g. `monitors`.
+	items string
+	// The computed map of names to the ID of each object, e.g. `monitor_guids`.
+	ids string
+	// The computed map of names to the error of the last failed operation on each object.
+	failed string
+	// The attributes every object is created from, whose change updates every object.
+	shared []string
+	// The other computed attributes derived from the objects, which are recomputed along
+	// with `ids` and `failed`.
+	computed []string
+	// How an object is named in warnings, e.g. `synthetics monitor`.
+	description string
+}
+
+// The operations applied to the objects of a keyedSet. Creating an object returns its ID.
+type keyedSetOperations struct {
+	create func(ctx context.Context, name string) (string, error)
+	update func(ctx context.Context, name string, id string) error
+	delete func(ctx context.Context, name string, id string) error
+}
+
+// An error carrying the diagnostics of a failed operation on an object of a keyedSet,
+// which are reported one by one.
+type keyedSetObjectError struct {
+	diags diag.Diagnostics
+}
+
+func (e keyedSetObjectError) Error() string {
+	return e.diags[0].Summary
+}
+
+// Forces an update of the set whenever an object of the set does not exist yet, or
+// failed to be updated previously, so that such objects are retried on the next apply.
+func (s keyedSet) customizeDiff(d *schema.ResourceDiff) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	ids := d.Get(s.ids).(map[string]interface{})
+	failed := d.Get(s.failed).(map[string]interface{})
+	items := d.Get(s.items).(map[string]interface{})
+
+	converged := len(failed) == 0 && len(ids) == len(items)
+	for name := range items {
+		if _, ok := ids[name]; !ok {
+			converged = false
+		}
+	}
+
+	if converged && !d.HasChange(s.items) && (len(s.shared) == 0 || !d.HasChanges(s.shared...)) {
+		return nil
+	}
+
+	for _, attr := range append([]string{s.ids, s.failed}, s.computed...) {
+		if err := d.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the names of the objects to create, update and delete, each sorted, given
+// the IDs of the existing objects and the previous and desired items. Objects without
+// an ID, e.g. ones which failed to be created previously, are created again. Existing
+// objects are updated when their value changed or their previous operation failed, or
+// all of them when `updateAll` is set because a shared attribute changed.
+func diffKeyedSet(existingIDs, failed, oldItems, newItems map[string]interface{}, updateAll bool) ([]string, []string, []string) {
+	toCreate := []string{}
+	toUpdate := []string{}
+	toDelete := []string{}
+
+	for name, value := range newItems {
+		if _, ok := existingIDs[name]; !ok {
+			toCreate = append(toCreate, name)
+			continue
+		}
+
+		_, hasFailed := failed[name]
+		if updateAll || hasFailed || oldItems[name] != value {
+			toUpdate = append(toUpdate, name)
+		}
+	}
+
+	for name := range existingIDs {
+		if _, ok := newItems[name]; !ok {
+			toDelete = append(toDelete, name)
+		}
+	}
+
+	sort.Strings(toCreate)
+	sort.Strings(toUpdate)
+	sort.Strings(toDelete)
+
+	return toCreate, toUpdate, toDelete
+}
+
+// Creates, updates and deletes the given objects of the set, with no more than
+// `concurrency` operations in flight at a time. Failures of individual objects are
+// recorded in the `failed` attribute and reported as warnings rather than errors, so
+// the rest of the set is still applied, and the IDs of the objects saved to state.
+func (s keyedSet) apply(
+	ctx context.Context,
+	d *schema.ResourceData,
+	concurrency int,
+	existingIDs map[string]interface{},
+	toCreate []string,
+	toUpdate []string,
+	toDelete []string,
+	ops keyedSetOperations,
+) diag.Diagnostics {
+	var mu sync.Mutex
+	ids := map[string]interface{}{}
+	for name, id := range existingIDs {
+		ids[name] = id
+	}
+
+	createErrs := runWithBoundedConcurrency(ctx, toCreate, concurrency, func(ctx context.Context, name string) error {
+		id, err := ops.create(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		ids[name] = id
+		mu.Unlock()
+
+		return nil
+	})
+
+	updateErrs := runWithBoundedConcurrency(ctx, toUpdate, concurrency, func(ctx context.Context, name string) error {
+		return ops.update(ctx, name, existingIDs[name].(string))
+	})
+
+	deleteErrs := runWithBoundedConcurrency(ctx, toDelete, concurrency, func(ctx context.Context, name string) error {
+		if err := ops.delete(ctx, name, existingIDs[name].(string)); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		delete(ids, name)
+		mu.Unlock()
+
+		return nil
+	})
+
+	var diags diag.Diagnostics
+	failed := map[string]interface{}{}
+	for _, errs := range []map[string]error{createErrs, updateErrs, deleteErrs} {
+		for name, err := range errs {
+			failed[name] = err.Error()
+
+			objectErr, ok := err.(keyedSetObjectError)
+			if !ok {
+				objectErr = keyedSetObjectError{diags: diag.FromErr(err)}
+			}
+
+			for _, objectDiag := range objectErr.diags {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("%s %q: %s", s.description, name, objectDiag.Summary),
+					Detail:   objectDiag.Detail,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Summary < diags[j].Summary
+	})
+
+	_ = d.Set(s.ids, ids)
+	_ = d.Set(s.failed, failed)
+
+	return diags
+}
+
+// The entities matching an entity search query, page by page, with the fields shared by
+// every entity outline, as the queries of the entities package only return the first page.
+const searchAllEntitiesQuery = `query(
+	$query: String!,
+	$cursor: String,
+) { actor { entitySearch(query: $query) {
+	results(cursor: $cursor) {
+		nextCursor
+		entities {
+			__typename
+			accountId
+			domain
+			entityType
+			guid
+			name
+			tags {
+				key
+				values
+			}
+			type
+		}
+	}
+} } }`
+
+type searchAllEntitiesResponse struct {
+	Actor struct {
+		EntitySearch entities.EntitySearch `json:"entitySearch"`
+	} `json:"actor"`
+}
+
+// Returns all the entities matching an entity search query, following the cursor of the
+// results until the last page.
+func searchAllEntities(ctx context.Context, client *newrelic.NewRelic, query string) ([]entities.EntityOutlineInterface, error) {
+	matched := []entities.EntityOutlineInterface{}
+	vars := map[string]interface{}{"query": query}
+
+	for {
+		resp := searchAllEntitiesResponse{}
+		if err := client.NerdGraph.QueryWithResponseAndContext(ctx, searchAllEntitiesQuery, vars, &resp); err != nil {
+			return nil, err
+		}
+
+		matched = append(matched, resp.Actor.EntitySearch.Results.Entities...)
+
+		if resp.Actor.EntitySearch.Results.NextCursor == "" {
+			return matched, nil
+		}
+		vars["cursor"] = resp.Actor.EntitySearch.Results.NextCursor
+	}
+}
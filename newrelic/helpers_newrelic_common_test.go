@@ -4,8 +4,12 @@
 package newrelic
 
 import (
+	"context"
+	"fmt"
+	"sync/atomic"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 )
@@ -112,3 +116,119 @@ func TestToJSON(t *testing.T) {
 
 	require.Contains(t, result, "test")
 }
+
+func TestRunWithBoundedConcurrency(t *testing.T) {
+	keys := []string{"a", "b", "c", "d", "e", "f"}
+
+	var inFlight, maxInFlight int32
+	errs := runWithBoundedConcurrency(context.Background(), keys, 2, func(ctx context.Context, key string) error {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+
+		if key == "c" || key == "e" {
+			return fmt.Errorf("failed %s", key)
+		}
+
+		return nil
+	})
+
+	require.LessOrEqual(t, int(maxInFlight), 2)
+	require.Equal(t, 2, len(errs))
+	require.EqualError(t, errs["c"], "failed c")
+	require.EqualError(t, errs["e"], "failed e")
+}
+
+func TestRunWithBoundedConcurrency_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	errs := runWithBoundedConcurrency(ctx, []string{"a", "b"}, 5, func(ctx context.Context, key string) error {
+		called = true
+		return nil
+	})
+
+	require.False(t, called)
+	require.Equal(t, 2, len(errs))
+}
+
+func TestDiffKeyedSet(t *testing.T) {
+	existingIDs := map[string]interface{}{
+		"unchanged": "id-1",
+		"moved":     "id-2",
+		"removed":   "id-3",
+		"broken":    "id-4",
+	}
+	failed := map[string]interface{}{
+		"broken":    "INVALID: bad uri",
+		"never-ran": "INVALID: bad uri",
+	}
+	oldItems := map[string]interface{}{
+		"unchanged": "https://a.example.com",
+		"moved":     "https://b.example.com",
+		"removed":   "https://c.example.com",
+		"broken":    "https://d.example.com",
+		"never-ran": "https://e.example.com",
+	}
+	newItems := map[string]interface{}{
+		"unchanged": "https://a.example.com",
+		"moved":     "https://b2.example.com",
+		"broken":    "https://d.example.com",
+		"never-ran": "https://e.example.com",
+		"added":     "https://f.example.com",
+	}
+
+	toCreate, toUpdate, toDelete := diffKeyedSet(existingIDs, failed, oldItems, newItems, false)
+	require.Equal(t, []string{"added", "never-ran"}, toCreate)
+	require.Equal(t, []string{"broken", "moved"}, toUpdate)
+	require.Equal(t, []string{"removed"}, toDelete)
+
+	_, toUpdate, _ = diffKeyedSet(existingIDs, map[string]interface{}{}, oldItems, newItems, true)
+	require.Equal(t, []string{"broken", "moved", "unchanged"}, toUpdate)
+}
+
+func TestKeyedSetApply(t *testing.T) {
+	set := keyedSet{items: "items", ids: "ids", failed: "failed", description: "object"}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"items":  {Type: schema.TypeMap, Elem: &schema.Schema{Type: schema.TypeString}, Optional: true},
+		"ids":    {Type: schema.TypeMap, Elem: &schema.Schema{Type: schema.TypeString}, Computed: true},
+		"failed": {Type: schema.TypeMap, Elem: &schema.Schema{Type: schema.TypeString}, Computed: true},
+	}, map[string]interface{}{})
+
+	existingIDs := map[string]interface{}{"kept": "id-1", "gone": "id-2", "stuck": "id-3"}
+
+	diags := set.apply(context.Background(), d, 2, existingIDs, []string{"new", "rejected"}, []string{"kept"}, []string{"gone", "stuck"}, keyedSetOperations{
+		create: func(ctx context.Context, name string) (string, error) {
+			if name == "rejected" {
+				return "", keyedSetObjectError{diags: diag.Diagnostics{{Summary: "INVALID", Detail: "bad value"}}}
+			}
+			return "id-" + name, nil
+		},
+		update: func(ctx context.Context, name string, id string) error {
+			return nil
+		},
+		delete: func(ctx context.Context, name string, id string) error {
+			if name == "stuck" {
+				return fmt.Errorf("in use")
+			}
+			return nil
+		},
+	})
+
+	require.Len(t, diags, 2)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, `object "rejected": INVALID`, diags[0].Summary)
+	require.Equal(t, "bad value", diags[0].Detail)
+	require.Equal(t, `object "stuck": in use`, diags[1].Summary)
+
+	// Objects which failed to be deleted are still tracked.
+	require.Equal(t, map[string]interface{}{"kept": "id-1", "new": "id-new", "stuck": "id-3"}, d.Get("ids"))
+	require.Equal(t, map[string]interface{}{"rejected": "INVALID", "stuck": "in use"}, d.Get("failed"))
+}
@@ -133,12 +133,7 @@ func fillData(d *schema.ResourceData, evaluationPeriod int, toleratedBudgetConsu
 		return err
 	}
 
-	var nrql string
-	if d.Get("is_bad_events").(bool) {
-		nrql = fmt.Sprintf("FROM Metric SELECT 100 - clamp_max((sum(newrelic.sli.valid) - sum(newrelic.sli.bad)) / sum(newrelic.sli.valid) * 100, 100) AS 'Error rate' WHERE entity.guid = '%v'", d.Get("sli_guid"))
-	} else {
-		nrql = fmt.Sprintf("FROM Metric SELECT 100 - clamp_max(sum(newrelic.sli.good) / sum(newrelic.sli.valid) * 100, 100) AS 'Error rate' WHERE entity.guid = '%v'", d.Get("sli_guid"))
-	}
+	nrql := serviceLevelAlertNrql(d.Get("sli_guid").(string), d.Get("is_bad_events").(bool))
 	if err := d.Set("nrql", nrql); err != nil {
 		return err
 	}
@@ -153,6 +148,15 @@ func fillData(d *schema.ResourceData, evaluationPeriod int, toleratedBudgetConsu
 	return nil
 }
 
+// Returns the query of the error rate of a service level, in percent.
+func serviceLevelAlertNrql(sliGUID string, isBadEvents bool) string {
+	if isBadEvents {
+		return fmt.Sprintf("FROM Metric SELECT 100 - clamp_max((sum(newrelic.sli.valid) - sum(newrelic.sli.bad)) / sum(newrelic.sli.valid) * 100, 100) AS 'Error rate' WHERE entity.guid = '%v'", sliGUID)
+	}
+
+	return fmt.Sprintf("FROM Metric SELECT 100 - clamp_max(sum(newrelic.sli.good) / sum(newrelic.sli.valid) * 100, 100) AS 'Error rate' WHERE entity.guid = '%v'", sliGUID)
+}
+
 func calculateThreshold(sloTarget float64, toleratedBudgetConsumption float64, sloPeriod int, evaluationPeriod int) float64 {
 	return (100.0 - sloTarget) * ((toleratedBudgetConsumption / 100 * float64(sloPeriod) * 24) / (float64(evaluationPeriod) / 3600.0))
 }
@@ -519,25 +519,35 @@ func getRuntimeValuesFromEntityTags(tags []entities.EntityTag) (runtimeType stri
 }
 
 func getMonitorID(monitorGUID string) (string, error) {
-	decodedGUID, err := base64.RawStdEncoding.DecodeString(monitorGUID)
+	return getSyntheticsEntityIDFromGUID(monitorGUID, "monitor")
+}
+
+// Returns the ID of a Synthetics private location, which is the identifier
+// used for the location in Synthetics events such as `SyntheticsPrivateMinion`.
+func getPrivateLocationID(locationGUID string) (string, error) {
+	return getSyntheticsEntityIDFromGUID(locationGUID, "private location")
+}
+
+// Synthetics entity GUIDs are base64 encoded strings of the format
+// "[accountID]|SYNTH|[entityType]|[entityID]".
+func getSyntheticsEntityIDFromGUID(guid string, kind string) (string, error) {
+	decodedGUID, err := base64.RawStdEncoding.DecodeString(guid)
 	if err != nil {
 		return "", err
 	}
 
 	// Check if "|" character is present in decodedGUID
 	if !strings.Contains(string(decodedGUID), "|") {
-		return "", fmt.Errorf("invalid monitor GUID '%s'", monitorGUID)
+		return "", fmt.Errorf("invalid %s GUID '%s'", kind, guid)
 	}
 
 	splitGUID := strings.Split(string(decodedGUID), "|")
 
 	if len(splitGUID) < 4 {
-		return "", fmt.Errorf("invalid monitor GUID '%s'", monitorGUID)
+		return "", fmt.Errorf("invalid %s GUID '%s'", kind, guid)
 	}
 
-	monitorID := splitGUID[3]
-
-	return monitorID, nil
+	return splitGUID[3], nil
 }
 
 // This map facilitates safely setting the schema attributes which
@@ -0,0 +1,512 @@
+// Command cloudintegrationsgen generates the schema, expand and flatten functions of the
+// cloud integrations services listed in cloud_integrations_catalog.json, and the tables of
+// the metrics polling intervals the services accept.
+//
+// It is run with `go generate` from the newrelic package:
+//
+//	go generate ./newrelic/...
+//
+// A service can only be added to the catalog once the newrelic-client-go version used by
+// the provider has the input and integration types of the service.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// catalog is the machine-readable list of cloud integrations services, per cloud provider.
+type catalog struct {
+	Aws   []service `json:"aws"`
+	Azure []service `json:"azure"`
+	// MetricsPollingIntervals are the polling intervals accepted by the services, catalog
+	// and hand-maintained ones alike, per cloud provider.
+	MetricsPollingIntervals map[string]providerPollingIntervals `json:"metrics_polling_intervals"`
+}
+
+// The polling intervals accepted by the services of a cloud provider: the ones of the
+// service when it is listed in Services, the Default ones otherwise.
+type providerPollingIntervals struct {
+	Default  pollingIntervals            `json:"default"`
+	Services map[string]pollingIntervals `json:"services"`
+}
+
+type pollingIntervals struct {
+	// Intervals are the accepted polling intervals, in seconds.
+	Intervals []int `json:"intervals"`
+	// Default is the interval a service is polled at when none is configured.
+	Default int `json:"default"`
+}
+
+type service struct {
+	// Key is the name of the nested block of the service in the resource schema.
+	Key string `json:"key"`
+	// Name is the CamelCase name used in the generated function names.
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// InputField is the name of the field of the service in the integrations inputs.
+	InputField string `json:"input_field"`
+	// ClientType is the prefix of the `<ClientType>Integration` and
+	// `<ClientType>IntegrationInput` types of the service in the cloud package.
+	ClientType string   `json:"client_type"`
+	Attributes []string `json:"attributes"`
+	// GovCloud is true when the AWS service is also supported by AWS GovCloud.
+	GovCloud bool `json:"govcloud"`
+}
+
+// A service of a cloud provider, with the prefix of the generated function names.
+type providerService struct {
+	service
+	Provider string
+}
+
+type attribute struct {
+	// Field is the name of the attribute in the input and integration types.
+	Field string
+	// Schema is the literal of the attribute schema.
+	Schema string
+	// Expand converts the raw value `v` of the attribute to the type of Field.
+	Expand string
+}
+
+// The attributes a catalog service can be configured with.
+var attributes = map[string]attribute{
+	"aws_regions": {
+		Field: "AwsRegions",
+		Schema: `{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Specify each AWS region that includes the resources that you want to monitor.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}`,
+		Expand: "expandStringSlice(v.([]interface{}))",
+	},
+	"fetch_extended_inventory": {
+		Field: "FetchExtendedInventory",
+		Schema: `{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Determine if extra inventory data be collected or not. May affect total data collection time and contribute to the Cloud provider API rate limit.",
+		}`,
+		Expand: "v.(bool)",
+	},
+	"fetch_tags": {
+		Field: "FetchTags",
+		Schema: `{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Specify if tags should be collected. May affect total data collection time and contribute to the Cloud provider API rate limit.",
+		}`,
+		Expand: "v.(bool)",
+	},
+	"metrics_polling_interval": {
+		Field: "MetricsPollingInterval",
+		Schema: `{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The data polling interval in seconds.",
+		}`,
+		Expand: "v.(int)",
+	},
+	"resource_groups": {
+		Field: "ResourceGroups",
+		Schema: `{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}`,
+		Expand: "expandStringSlice(v.([]interface{}))",
+	},
+	"tag_key": {
+		Field: "TagKey",
+		Schema: `{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specify a Tag key associated with the resources that you want to monitor. Filter values are case-sensitive.",
+		}`,
+		Expand: "v.(string)",
+	},
+	"tag_keys": {
+		Field: "TagKeys",
+		Schema: `{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Specify if additional cost data per tag should be collected. This field is case sensitive.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}`,
+		Expand: "expandStringSlice(v.([]interface{}))",
+	},
+	"tag_value": {
+		Field: "TagValue",
+		Schema: `{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specify a Tag value associated with the resources that you want to monitor. Filter values are case-sensitive.",
+		}`,
+		Expand: "v.(string)",
+	},
+}
+
+var (
+	keyRegexp        = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	identifierRegexp = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+)
+
+func main() {
+	in := flag.String("catalog", "cloud_integrations_catalog.json", "path of the catalog")
+	out := flag.String("output", "zz_generated_cloud_integrations.go", "path of the generated file")
+	flag.Parse()
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var c catalog
+	if err = json.Unmarshal(data, &c); err != nil {
+		log.Fatalf("invalid catalog %s: %s", *in, err)
+	}
+
+	if err = validate(c.Aws); err != nil {
+		log.Fatalf("invalid catalog %s: %s", *in, err)
+	}
+
+	if err = validate(c.Azure); err != nil {
+		log.Fatalf("invalid catalog %s: %s", *in, err)
+	}
+
+	for _, s := range c.Azure {
+		if s.GovCloud {
+			log.Fatalf("invalid catalog %s: %s: govcloud only applies to AWS services", *in, s.Key)
+		}
+	}
+
+	if err = validatePollingIntervals(c.MetricsPollingIntervals); err != nil {
+		log.Fatalf("invalid catalog %s: %s", *in, err)
+	}
+
+	src, err := generate(*in, c)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(*out, src, 0o644); err != nil { // #nosec G306 -- generated source file
+		log.Fatal(err)
+	}
+}
+
+func validate(services []service) error {
+	keys := map[string]bool{}
+
+	for _, s := range services {
+		if !keyRegexp.MatchString(s.Key) {
+			return fmt.Errorf("invalid key %q", s.Key)
+		}
+		if keys[s.Key] {
+			return fmt.Errorf("duplicate key %q", s.Key)
+		}
+		keys[s.Key] = true
+
+		for _, identifier := range []string{s.Name, s.InputField, s.ClientType} {
+			if !identifierRegexp.MatchString(identifier) {
+				return fmt.Errorf("%s: invalid identifier %q", s.Key, identifier)
+			}
+		}
+
+		if s.Description == "" {
+			return fmt.Errorf("%s: missing description", s.Key)
+		}
+
+		if len(s.Attributes) == 0 {
+			return fmt.Errorf("%s: missing attributes", s.Key)
+		}
+
+		for _, a := range s.Attributes {
+			if _, ok := attributes[a]; !ok {
+				return fmt.Errorf("%s: unknown attribute %q", s.Key, a)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePollingIntervals(providers map[string]providerPollingIntervals) error {
+	for provider, p := range providers {
+		// Only the tables of the AWS services are generated so far.
+		if provider != "aws" {
+			return fmt.Errorf("metrics_polling_intervals: unsupported provider %q", provider)
+		}
+
+		if err := validateIntervals(provider+" default", p.Default); err != nil {
+			return err
+		}
+
+		for key, intervals := range p.Services {
+			if !keyRegexp.MatchString(key) {
+				return fmt.Errorf("metrics_polling_intervals: invalid key %q", key)
+			}
+			if err := validateIntervals(key, intervals); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, ok := providers["aws"]; !ok {
+		return fmt.Errorf("metrics_polling_intervals: missing aws")
+	}
+
+	return nil
+}
+
+func validateIntervals(name string, p pollingIntervals) error {
+	if len(p.Intervals) == 0 {
+		return fmt.Errorf("metrics_polling_intervals: %s: missing intervals", name)
+	}
+
+	for i, interval := range p.Intervals {
+		if interval <= 0 || (i > 0 && interval <= p.Intervals[i-1]) {
+			return fmt.Errorf("metrics_polling_intervals: %s: intervals must be positive and increasing", name)
+		}
+	}
+
+	for _, interval := range p.Intervals {
+		if interval == p.Default {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("metrics_polling_intervals: %s: default %d is not one of the intervals", name, p.Default)
+}
+
+func generate(source string, c catalog) ([]byte, error) {
+	services := withProvider("Aws", c.Aws)
+	azureServices := withProvider("Azure", c.Azure)
+
+	var govCloudServices []providerService
+	for _, s := range services {
+		if s.GovCloud {
+			govCloudServices = append(govCloudServices, s)
+		}
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, map[string]interface{}{
+		"Source":           source,
+		"Services":         services,
+		"GovCloudServices": govCloudServices,
+		"AzureServices":    azureServices,
+		"AwsIntervals":     c.MetricsPollingIntervals["aws"],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+// Returns the services sorted by key, with the prefix of the generated function names.
+func withProvider(provider string, services []service) []providerService {
+	out := make([]providerService, 0, len(services))
+	for _, s := range services {
+		out = append(out, providerService{service: s, Provider: provider})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Key < out[j].Key
+	})
+
+	return out
+}
+
+var tmpl = template.Must(template.New("").Funcs(template.FuncMap{
+	"attribute": func(name string) attribute { return attributes[name] },
+	"ints": func(ints []int) string {
+		out := make([]string, 0, len(ints))
+		for _, i := range ints {
+			out = append(out, strconv.Itoa(i))
+		}
+		return strings.Join(out, ", ")
+	},
+}).Parse(`// Code generated by cloudintegrationsgen from {{ .Source }}. DO NOT EDIT.
+
+package newrelic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+{{ define "schema" -}}
+	{{- range . }}
+		"{{ .Key }}": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "{{ .Description }}",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					{{- range .Attributes }}
+					"{{ . }}": {{ (attribute .).Schema }},
+					{{- end }}
+				},
+			},
+			MaxItems: 1,
+		},
+	{{- end }}
+{{- end }}
+
+{{ define "enableDisable" -}}
+	{{- range . }}
+		"{{ .Key }}": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.{{ .InputField }} = expandCloud{{ .Provider }}Integration{{ .Name }}Input(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.{{ .InputField }} = []cloud.CloudDisableAccountIntegrationInput{{ "{{" }}LinkedAccountId: id{{ "}}" }}
+			},
+		},
+	{{- end }}
+{{- end }}
+
+{{ define "flatten" -}}
+	switch t := integration.(type) {
+	{{- range . }}
+	case *cloud.{{ .ClientType }}Integration:
+		_ = d.Set("{{ .Key }}", flattenCloud{{ .Provider }}{{ .Name }}Integration(t))
+	{{- end }}
+	default:
+		return false
+	}
+
+	return true
+{{- end }}
+
+// The metrics polling intervals of the AWS services which are not listed in cloudAwsServiceMetricsPollingIntervals.
+var cloudAwsDefaultMetricsPollingIntervals = cloudIntegrationMetricsPollingIntervals{
+	intervals:       []int{ {{- ints .AwsIntervals.Default.Intervals -}} },
+	defaultInterval: {{ .AwsIntervals.Default.Default }},
+}
+
+// The metrics polling intervals of the AWS services which differ from the default ones, by key.
+var cloudAwsServiceMetricsPollingIntervals = map[string]cloudIntegrationMetricsPollingIntervals{
+	{{- range $key, $p := .AwsIntervals.Services }}
+	"{{ $key }}": {intervals: []int{ {{- ints $p.Intervals -}} }, defaultInterval: {{ $p.Default }}},
+	{{- end }}
+}
+
+// Schema of the catalog services of the newrelic_cloud_aws_integrations resource.
+func cloudAwsCatalogIntegrationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		{{- template "schema" .Services }}
+	}
+}
+
+// Schema of the catalog services of the newrelic_cloud_aws_govcloud_integrations resource.
+func cloudAwsGovCloudCatalogIntegrationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		{{- template "schema" .GovCloudServices }}
+	}
+}
+
+// Functions enabling and disabling the catalog services of the newrelic_cloud_aws_integrations resource, by key.
+func cloudAwsCatalogIntegrations(enable *cloud.CloudAwsIntegrationsInput, disable *cloud.CloudAwsDisableIntegrationsInput) map[string]enableDisableCloudIntegration {
+	return map[string]enableDisableCloudIntegration{
+		{{- template "enableDisable" .Services }}
+	}
+}
+
+// Functions enabling and disabling the catalog services of the newrelic_cloud_aws_govcloud_integrations resource, by key.
+func cloudAwsGovCloudCatalogIntegrations(enable *cloud.CloudAwsGovcloudIntegrationsInput, disable *cloud.CloudAwsGovcloudDisableIntegrationsInput) map[string]enableDisableCloudIntegration {
+	return map[string]enableDisableCloudIntegration{
+		{{- template "enableDisable" .GovCloudServices }}
+	}
+}
+
+// Schema of the catalog services of the newrelic_cloud_azure_integrations resource.
+func cloudAzureCatalogIntegrationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		{{- template "schema" .AzureServices }}
+	}
+}
+
+// Functions enabling and disabling the catalog services of the newrelic_cloud_azure_integrations resource, by key.
+func cloudAzureCatalogIntegrations(enable *cloud.CloudAzureIntegrationsInput, disable *cloud.CloudAzureDisableIntegrationsInput) map[string]enableDisableCloudIntegration {
+	return map[string]enableDisableCloudIntegration{
+		{{- template "enableDisable" .AzureServices }}
+	}
+}
+
+// Sets the catalog service of the newrelic_cloud_azure_integrations resource matching the given integration.
+// Returns false when the integration is not a catalog service.
+func flattenCloudAzureCatalogIntegration(d *schema.ResourceData, integration cloud.CloudIntegrationInterface) bool {
+	{{ template "flatten" .AzureServices }}
+}
+
+// Sets the catalog service of the newrelic_cloud_aws_integrations resource matching the given integration.
+// Returns false when the integration is not a catalog service.
+func flattenCloudAwsCatalogIntegration(d *schema.ResourceData, integration cloud.CloudIntegrationInterface) bool {
+	{{ template "flatten" .Services }}
+}
+
+// Sets the catalog service of the newrelic_cloud_aws_govcloud_integrations resource matching the given integration.
+// Returns false when the integration is not a catalog service.
+func flattenCloudAwsGovCloudCatalogIntegration(d *schema.ResourceData, integration cloud.CloudIntegrationInterface) bool {
+	{{ template "flatten" .GovCloudServices }}
+}
+{{ define "functions" }}
+func expandCloud{{ .Provider }}Integration{{ .Name }}Input(b []interface{}, linkedAccountID int) []cloud.{{ .ClientType }}IntegrationInput {
+	expanded := make([]cloud.{{ .ClientType }}IntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		{{- range .Attributes }}
+		{{- $a := attribute . }}
+
+		if v, ok := in["{{ . }}"]; ok {
+			expanded[i].{{ $a.Field }} = {{ $a.Expand }}
+		}
+		{{- end }}
+	}
+
+	return expanded
+}
+
+func flattenCloud{{ .Provider }}{{ .Name }}Integration(in *cloud.{{ .ClientType }}Integration) []interface{} {
+	out := make(map[string]interface{})
+	{{ range .Attributes }}
+	out["{{ . }}"] = in.{{ (attribute .).Field }}
+	{{- end }}
+
+	return []interface{}{out}
+}
+{{ end }}
+{{ range .Services }}{{ template "functions" . }}{{ end }}
+{{ range .AzureServices }}{{ template "functions" . }}{{ end }}`))
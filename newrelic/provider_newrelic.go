@@ -120,22 +120,34 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"newrelic_account":                      dataSourceNewRelicAccount(),
-			"newrelic_alert_channel":                dataSourceNewRelicAlertChannel(),
-			"newrelic_alert_policy":                 dataSourceNewRelicAlertPolicy(),
-			"newrelic_application":                  dataSourceNewRelicApplication(),
-			"newrelic_authentication_domain":        dataSourceNewRelicAuthenticationDomain(),
-			"newrelic_cloud_account":                dataSourceNewRelicCloudAccount(),
-			"newrelic_entity":                       dataSourceNewRelicEntity(),
-			"newrelic_group":                        dataSourceNewRelicGroup(),
-			"newrelic_key_transaction":              dataSourceNewRelicKeyTransaction(),
-			"newrelic_notification_destination":     dataSourceNewRelicNotificationDestination(),
-			"newrelic_obfuscation_expression":       dataSourceNewRelicObfuscationExpression(),
-			"newrelic_synthetics_private_location":  dataSourceNewRelicSyntheticsPrivateLocation(),
-			"newrelic_synthetics_secure_credential": dataSourceNewRelicSyntheticsSecureCredential(),
-			"newrelic_test_grok_pattern":            dataSourceNewRelicTestGrokPattern(),
-			"newrelic_service_level_alert_helper":   dataSourceNewRelicServiceLevelAlertHelper(),
-			"newrelic_user":                         dataSourceNewRelicUser(),
+			"newrelic_account":                              dataSourceNewRelicAccount(),
+			"newrelic_alert_channel":                        dataSourceNewRelicAlertChannel(),
+			"newrelic_alert_policy":                         dataSourceNewRelicAlertPolicy(),
+			"newrelic_application":                          dataSourceNewRelicApplication(),
+			"newrelic_authentication_domain":                dataSourceNewRelicAuthenticationDomain(),
+			"newrelic_cloud_account":                        dataSourceNewRelicCloudAccount(),
+			"newrelic_cloud_aws_integrations_cost_estimate": dataSourceNewRelicCloudAwsIntegrationsCostEstimate(),
+			"newrelic_cloud_integration_coverage":           dataSourceNewRelicCloudIntegrationCoverage(),
+			"newrelic_cloud_linked_accounts":                dataSourceNewRelicCloudLinkedAccounts(),
+			"newrelic_entity":                               dataSourceNewRelicEntity(),
+			"newrelic_entity_relationships":                 dataSourceNewRelicEntityRelationships(),
+			"newrelic_group":                                dataSourceNewRelicGroup(),
+			"newrelic_key_transaction":                      dataSourceNewRelicKeyTransaction(),
+			"newrelic_monitor_downtime_calendar":            dataSourceNewRelicMonitorDowntimeCalendar(),
+			"newrelic_notification_destination":             dataSourceNewRelicNotificationDestination(),
+			"newrelic_obfuscation_expression":               dataSourceNewRelicObfuscationExpression(),
+			"newrelic_synthetics_monitor_results":           dataSourceNewRelicSyntheticsMonitorResults(),
+			"newrelic_synthetics_monitor_targets":           dataSourceNewRelicSyntheticsMonitorTargets(),
+			"newrelic_synthetics_private_location":          dataSourceNewRelicSyntheticsPrivateLocation(),
+			"newrelic_synthetics_private_location_status":   dataSourceNewRelicSyntheticsPrivateLocationStatus(),
+			"newrelic_synthetics_secure_credential":         dataSourceNewRelicSyntheticsSecureCredential(),
+			"newrelic_test_grok_pattern":                    dataSourceNewRelicTestGrokPattern(),
+			"newrelic_service_level_alert_helper":           dataSourceNewRelicServiceLevelAlertHelper(),
+			"newrelic_service_level_status":                 dataSourceNewRelicServiceLevelStatus(),
+			"newrelic_service_level_template":               dataSourceNewRelicServiceLevelTemplate(),
+			"newrelic_user":                                 dataSourceNewRelicUser(),
+			"newrelic_workload_members":                     dataSourceNewRelicWorkloadMembers(),
+			"newrelic_workload_status_preview":              dataSourceNewRelicWorkloadStatusPreview(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -152,13 +164,20 @@ func Provider() *schema.Provider {
 			"newrelic_cloud_aws_govcloud_integrations":          resourceNewRelicAwsGovCloudIntegrations(),
 			"newrelic_cloud_aws_integrations":                   resourceNewRelicCloudAwsIntegrations(),
 			"newrelic_cloud_aws_link_account":                   resourceNewRelicCloudAwsAccountLinkAccount(),
+			"newrelic_cloud_aws_link_accounts":                  resourceNewRelicCloudAwsLinkAccounts(),
+			"newrelic_cloud_aws_onboarding":                     resourceNewRelicCloudAwsOnboarding(),
 			"newrelic_cloud_azure_link_account":                 resourceNewRelicCloudAzureLinkAccount(),
+			"newrelic_cloud_azure_link_accounts":                resourceNewRelicCloudAzureLinkAccounts(),
 			"newrelic_cloud_azure_integrations":                 resourceNewRelicCloudAzureIntegrations(),
 			"newrelic_cloud_gcp_integrations":                   resourceNewrelicCloudGcpIntegrations(),
 			"newrelic_cloud_gcp_link_account":                   resourceNewRelicCloudGcpLinkAccount(),
+			"newrelic_cloud_gcp_link_accounts":                  resourceNewRelicCloudGcpLinkAccounts(),
+			"newrelic_cloud_oci_integrations":                   resourceNewRelicCloudOciIntegrations(),
 			"newrelic_cloud_oci_link_account":                   resourceNewRelicCloudOciAccountLinkAccount(),
 			"newrelic_data_partition_rule":                      resourceNewRelicDataPartition(),
+			"newrelic_entity_relationship":                      resourceNewRelicEntityRelationship(),
 			"newrelic_entity_tags":                              resourceNewRelicEntityTags(),
+			"newrelic_entity_tags_bulk":                         resourceNewRelicEntityTagsBulk(),
 			"newrelic_events_to_metrics_rule":                   resourceNewRelicEventsToMetricsRule(),
 			"newrelic_group":                                    resourceNewRelicGroup(),
 			"newrelic_infra_alert_condition":                    resourceNewRelicInfraAlertCondition(),
@@ -177,10 +196,13 @@ func Provider() *schema.Provider {
 			"newrelic_one_dashboard_raw":                        resourceNewRelicOneDashboardRaw(),
 			"newrelic_one_dashboard_json":                       resourceNewRelicOneDashboardJSON(),
 			"newrelic_service_level":                            resourceNewRelicServiceLevel(),
+			"newrelic_service_level_alert":                      resourceNewRelicServiceLevelAlert(),
+			"newrelic_service_level_composite":                  resourceNewRelicServiceLevelComposite(),
 			"newrelic_synthetics_alert_condition":               resourceNewRelicSyntheticsAlertCondition(),
 			"newrelic_synthetics_broken_links_monitor":          resourceNewRelicSyntheticsBrokenLinksMonitor(),
 			"newrelic_synthetics_cert_check_monitor":            resourceNewRelicSyntheticsCertCheckMonitor(),
 			"newrelic_synthetics_monitor":                       resourceNewRelicSyntheticsMonitor(),
+			"newrelic_synthetics_monitor_set":                   resourceNewRelicSyntheticsMonitorSet(),
 			"newrelic_synthetics_script_monitor":                resourceNewRelicSyntheticsScriptMonitor(),
 			"newrelic_synthetics_multilocation_alert_condition": resourceNewRelicSyntheticsMultiLocationAlertCondition(),
 			"newrelic_synthetics_private_location":              resourceNewRelicSyntheticsPrivateLocation(),
@@ -16,10 +16,10 @@ func resourceNewRelicAwsGovCloudIntegrations() *schema.Resource {
 		UpdateContext: resourceNewRelicAwsGovCloudIntegrationsUpdate,
 		DeleteContext: resourceNewRelicAwsGovCloudIntegrationsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importCloudIntegrations,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: mergeSchemas(map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -48,27 +48,6 @@ func resourceNewRelicAwsGovCloudIntegrations() *schema.Resource {
 				Elem:        AwsGovCloudIntegrationAPIGatewayElem(),
 				MaxItems:    1,
 			},
-			"auto_scaling": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "The auto scaling integration",
-				Elem:        AwsGovCloudIntegrationAutoScalingElem(),
-				MaxItems:    1,
-			},
-			"aws_direct_connect": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "The aws direct connect integration",
-				Elem:        AwsGovCloudIntegrationAwsDirectConnectElem(),
-				MaxItems:    1,
-			},
-			"aws_states": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "The aws states integration",
-				Elem:        AwsGovCloudIntegrationAwsStatesElem(),
-				MaxItems:    1,
-			},
 			"cloudtrail": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -174,7 +153,7 @@ func resourceNewRelicAwsGovCloudIntegrations() *schema.Resource {
 				Elem:        AwsGovCloudIntegrationSqsElem(),
 				MaxItems:    1,
 			},
-		},
+		}, cloudAwsGovCloudCatalogIntegrationsSchema()),
 	}
 }
 
@@ -185,6 +164,7 @@ func AwsGovCloudIntegrationSchemaBase() map[string]*schema.Schema {
 		"metrics_polling_interval": {
 			Type:        schema.TypeInt,
 			Optional:    true,
+			Computed:    true,
 			Description: "The data polling interval in seconds",
 		},
 	}
@@ -271,57 +251,6 @@ func AwsGovCloudIntegrationAPIGatewayElem() *schema.Resource {
 	}
 }
 
-//function to add schema for autoscaling
-
-func AwsGovCloudIntegrationAutoScalingElem() *schema.Resource {
-	s := AwsGovCloudIntegrationSchemaBase()
-	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
-		Description: "Specify each AWS region that includes the resources that you want to monitor",
-		Optional:    true,
-		Elem: &schema.Schema{
-			Type: schema.TypeString,
-		},
-	}
-	return &schema.Resource{
-		Schema: s,
-	}
-}
-
-//function to add schema for aws direct connect
-
-func AwsGovCloudIntegrationAwsDirectConnectElem() *schema.Resource {
-	s := AwsGovCloudIntegrationSchemaBase()
-	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
-		Description: "Specify each AWS region that includes the resources that you want to monitor",
-		Optional:    true,
-		Elem: &schema.Schema{
-			Type: schema.TypeString,
-		},
-	}
-	return &schema.Resource{
-		Schema: s,
-	}
-}
-
-//function to add schema for aws states
-
-func AwsGovCloudIntegrationAwsStatesElem() *schema.Resource {
-	s := AwsGovCloudIntegrationSchemaBase()
-	s["aws_regions"] = &schema.Schema{
-		Type:        schema.TypeList,
-		Description: "Specify each AWS region that includes the resources that you want to monitor",
-		Optional:    true,
-		Elem: &schema.Schema{
-			Type: schema.TypeString,
-		},
-	}
-	return &schema.Resource{
-		Schema: s,
-	}
-}
-
 //function to add schema for cloud trail
 
 func AwsGovCloudIntegrationCloudTrailElem() *schema.Resource {
@@ -831,21 +760,6 @@ func expandAwsGovCloudIntegrationsInput(d *schema.ResourceData) (cloud.CloudInte
 	} else if o, n := d.GetChange("api_gateway"); len(n.([]interface{})) < len(o.([]interface{})) {
 		cloudDisableAwsGovCloudIntegration.APIgateway = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
 	}
-	if v, ok := d.GetOk("auto_scaling"); ok {
-		awsGovCloudIntegration.Autoscaling = expandAwsGovCloudIntegrationsAutoScalingInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("auto_scaling"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.Autoscaling = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("aws_direct_connect"); ok {
-		awsGovCloudIntegration.AwsDirectconnect = expandAwsGovCloudIntegrationsAwsDirectConnectInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("aws_direct_connect"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.AwsDirectconnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if v, ok := d.GetOk("aws_states"); ok {
-		awsGovCloudIntegration.AwsStates = expandAwsGovCloudIntegrationsAwsStatesInput(v.([]interface{}), linkedAccountID)
-	} else if o, n := d.GetChange("aws_states"); len(n.([]interface{})) < len(o.([]interface{})) {
-		cloudDisableAwsGovCloudIntegration.AwsStates = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
 	if v, ok := d.GetOk("cloudtrail"); ok {
 		awsGovCloudIntegration.Cloudtrail = expandAwsGovCloudIntegrationsCloudtrailInput(v.([]interface{}), linkedAccountID)
 	} else if o, n := d.GetChange("cloudtrail"); len(n.([]interface{})) < len(o.([]interface{})) {
@@ -921,6 +835,13 @@ func expandAwsGovCloudIntegrationsInput(d *schema.ResourceData) (cloud.CloudInte
 	} else if o, n := d.GetChange("sqs"); len(n.([]interface{})) < len(o.([]interface{})) {
 		cloudDisableAwsGovCloudIntegration.Sqs = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
 	}
+	for key, fun := range cloudAwsGovCloudCatalogIntegrations(&awsGovCloudIntegration, &cloudDisableAwsGovCloudIntegration) {
+		if v, ok := d.GetOk(key); ok {
+			fun.enableFunc(v.([]interface{}), linkedAccountID)
+		} else if o, n := d.GetChange(key); len(n.([]interface{})) < len(o.([]interface{})) {
+			fun.disableFunc(linkedAccountID)
+		}
+	}
 
 	configureInput := cloud.CloudIntegrationsInput{
 		AwsGovcloud: awsGovCloudIntegration,
@@ -1053,112 +974,10 @@ func expandAwsGovCloudIntegrationsAPIGatewayInput(b []interface{}, linkedAccount
 }
 
 // Expanding the auto scaling
-func expandAwsGovCloudIntegrationsAutoScalingInput(b []interface{}, linkedAccountID int) []cloud.CloudAutoscalingIntegrationInput {
-	expanded := make([]cloud.CloudAutoscalingIntegrationInput, len(b))
-
-	for i, autoScaling := range b {
-		var autoScalingInput cloud.CloudAutoscalingIntegrationInput
-
-		if autoScaling == nil {
-			autoScalingInput.LinkedAccountId = linkedAccountID
-			expanded[i] = autoScalingInput
-			return expanded
-		}
-
-		in := autoScaling.(map[string]interface{})
-
-		autoScalingInput.LinkedAccountId = linkedAccountID
-
-		if m, ok := in["metrics_polling_interval"]; ok {
-			autoScalingInput.MetricsPollingInterval = m.(int)
-		}
-		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
-			var regions []string
-
-			for _, region := range awsRegions {
-				regions = append(regions, region.(string))
-			}
-			autoScalingInput.AwsRegions = regions
-		}
-
-		expanded[i] = autoScalingInput
-	}
-
-	return expanded
-}
 
 // Expanding the aws direct
-func expandAwsGovCloudIntegrationsAwsDirectConnectInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsDirectconnectIntegrationInput {
-	expanded := make([]cloud.CloudAwsDirectconnectIntegrationInput, len(b))
-
-	for i, awsDirectConnect := range b {
-		var awsDirectConnectInput cloud.CloudAwsDirectconnectIntegrationInput
-
-		if awsDirectConnect == nil {
-			awsDirectConnectInput.LinkedAccountId = linkedAccountID
-			expanded[i] = awsDirectConnectInput
-			return expanded
-		}
-
-		in := awsDirectConnect.(map[string]interface{})
-
-		awsDirectConnectInput.LinkedAccountId = linkedAccountID
-
-		if m, ok := in["metrics_polling_interval"]; ok {
-			awsDirectConnectInput.MetricsPollingInterval = m.(int)
-		}
-		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
-			var regions []string
-
-			for _, region := range awsRegions {
-				regions = append(regions, region.(string))
-			}
-			awsDirectConnectInput.AwsRegions = regions
-		}
-
-		expanded[i] = awsDirectConnectInput
-	}
-
-	return expanded
-}
 
 // Expanding the aws states
-func expandAwsGovCloudIntegrationsAwsStatesInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsStatesIntegrationInput {
-	expanded := make([]cloud.CloudAwsStatesIntegrationInput, len(b))
-
-	for i, awsStates := range b {
-		var awsStatesInput cloud.CloudAwsStatesIntegrationInput
-
-		if awsStates == nil {
-			awsStatesInput.LinkedAccountId = linkedAccountID
-			expanded[i] = awsStatesInput
-			return expanded
-		}
-
-		in := awsStates.(map[string]interface{})
-
-		awsStatesInput.LinkedAccountId = linkedAccountID
-
-		if m, ok := in["metrics_polling_interval"]; ok {
-			awsStatesInput.MetricsPollingInterval = m.(int)
-		}
-		if a, ok := in["aws_regions"]; ok {
-			awsRegions := a.([]interface{})
-			var regions []string
-
-			for _, region := range awsRegions {
-				regions = append(regions, region.(string))
-			}
-			awsStatesInput.AwsRegions = regions
-		}
-
-		expanded[i] = awsStatesInput
-	}
-
-	return expanded
-}
 
 // Expanding the cloudtrail
 func expandAwsGovCloudIntegrationsCloudtrailInput(b []interface{}, linkedAccountID int) []cloud.CloudCloudtrailIntegrationInput {
@@ -1827,9 +1646,9 @@ func resourceNewRelicAwsGovCloudIntegrationsRead(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
-	flattenAwsGovCloudLinkedAccount(d, linkedAccount)
-
-	return nil
+	return readCloudIntegrations(d, cloudIntegrationsServiceKeys(resourceNewRelicAwsGovCloudIntegrations().Schema), func() {
+		flattenAwsGovCloudLinkedAccount(d, linkedAccount)
+	})
 }
 
 /// flatten
@@ -1840,17 +1659,15 @@ func flattenAwsGovCloudLinkedAccount(d *schema.ResourceData, result *cloud.Cloud
 	_ = d.Set("linked_account_id", result.ID)
 
 	for _, i := range result.Integrations {
+		if flattenCloudAwsGovCloudCatalogIntegration(d, i) {
+			continue
+		}
+
 		switch t := i.(type) {
 		case *cloud.CloudAlbIntegration:
 			_ = d.Set("alb", flattenAwsGovCloudAlbIntegration(t))
 		case *cloud.CloudAPIgatewayIntegration:
 			_ = d.Set("api_gateway", flattenAwsGovCloudAPIGatewayIntegration(t))
-		case *cloud.CloudAutoscalingIntegration:
-			_ = d.Set("auto_scaling", flattenAwsGovCloudAutoScalingIntegration(t))
-		case *cloud.CloudAwsDirectconnectIntegration:
-			_ = d.Set("aws_direct_connect", flattenAwsGovCloudDirectconnectIntegration(t))
-		case *cloud.CloudAwsStatesIntegration:
-			_ = d.Set("aws_states", flattenAwsGovCloudAwsStatesIntegration(t))
 		case *cloud.CloudCloudtrailIntegration:
 			_ = d.Set("cloudtrail", flattenAwsGovCloudCloudtrailIntegration(t))
 		case *cloud.CloudDynamodbIntegration:
@@ -1924,51 +1741,6 @@ func flattenAwsGovCloudAPIGatewayIntegration(in *cloud.CloudAPIgatewayIntegratio
 	return flattened
 }
 
-//flatten for autoscaling
-
-func flattenAwsGovCloudAutoScalingIntegration(in *cloud.CloudAutoscalingIntegration) []interface{} {
-	flattened := make([]interface{}, 1)
-
-	out := make(map[string]interface{})
-
-	out["metrics_polling_interval"] = in.MetricsPollingInterval
-	out["aws_regions"] = in.AwsRegions
-
-	flattened[0] = out
-
-	return flattened
-}
-
-//flatten for direct connect
-
-func flattenAwsGovCloudDirectconnectIntegration(in *cloud.CloudAwsDirectconnectIntegration) []interface{} {
-	flattened := make([]interface{}, 1)
-
-	out := make(map[string]interface{})
-
-	out["metrics_polling_interval"] = in.MetricsPollingInterval
-	out["aws_regions"] = in.AwsRegions
-
-	flattened[0] = out
-
-	return flattened
-}
-
-//flatten for aws states
-
-func flattenAwsGovCloudAwsStatesIntegration(in *cloud.CloudAwsStatesIntegration) []interface{} {
-	flattened := make([]interface{}, 1)
-
-	out := make(map[string]interface{})
-
-	out["metrics_polling_interval"] = in.MetricsPollingInterval
-	out["aws_regions"] = in.AwsRegions
-
-	flattened[0] = out
-
-	return flattened
-}
-
 //flatten for cloudtrail
 
 func flattenAwsGovCloudCloudtrailIntegration(in *cloud.CloudCloudtrailIntegration) []interface{} {
@@ -2310,15 +2082,6 @@ func expandAwsGovCloudDisableInputs(d *schema.ResourceData) cloud.CloudDisableIn
 	if _, ok := d.GetOk("api_gateway"); ok {
 		awsGovCloudDisableInputs.APIgateway = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
 	}
-	if _, ok := d.GetOk("auto_scaling"); ok {
-		awsGovCloudDisableInputs.Autoscaling = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if _, ok := d.GetOk("aws_direct_connect"); ok {
-		awsGovCloudDisableInputs.AwsDirectconnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
-	if _, ok := d.GetOk("aws_states"); ok {
-		awsGovCloudDisableInputs.AwsStates = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
-	}
 	if _, ok := d.GetOk("cloudtrail"); ok {
 		awsGovCloudDisableInputs.Cloudtrail = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
 	}
@@ -2361,6 +2124,11 @@ func expandAwsGovCloudDisableInputs(d *schema.ResourceData) cloud.CloudDisableIn
 	if _, ok := d.GetOk("sqs"); ok {
 		awsGovCloudDisableInputs.Sqs = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
 	}
+	for key, fun := range cloudAwsGovCloudCatalogIntegrations(&cloud.CloudAwsGovcloudIntegrationsInput{}, &awsGovCloudDisableInputs) {
+		if _, ok := d.GetOk(key); ok {
+			fun.disableFunc(linkedAccountID)
+		}
+	}
 	deleteInput := cloud.CloudDisableIntegrationsInput{
 		AwsGovcloud: awsGovCloudDisableInputs,
 	}
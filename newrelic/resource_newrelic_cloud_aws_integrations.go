@@ -16,9 +16,9 @@ func resourceNewRelicCloudAwsIntegrations() *schema.Resource {
 		UpdateContext: resourceNewRelicCloudAwsIntegrationsUpdate,
 		DeleteContext: resourceNewRelicCloudAwsIntegrationsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importCloudIntegrations,
 		},
-		Schema: map[string]*schema.Schema{
+		Schema: mergeSchemas(map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -38,13 +38,6 @@ func resourceNewRelicCloudAwsIntegrations() *schema.Resource {
 				Elem:        cloudAwsIntegrationBillingSchemaElem(),
 				MaxItems:    1,
 			},
-			"doc_db": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Doc DB integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
 			"cloudtrail": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -122,153 +115,6 @@ func resourceNewRelicCloudAwsIntegrations() *schema.Resource {
 				Elem:        cloudAwsIntegrationAPIGatewaySchemaElem(),
 				MaxItems:    1,
 			},
-			"auto_scaling": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "AutoScaling integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_app_sync": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Appsync integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_athena": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Athena integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_cognito": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Cognito integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_connect": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Connect integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_direct_connect": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Direct Connect integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_fsx": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Fsx integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_glue": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Glue integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_kinesis_analytics": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Kinesis Analytics integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_media_convert": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Media Convert integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_media_package_vod": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Media PackageVod integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_mq": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Mq integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_msk": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Msk integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_neptune": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Neptune integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_qldb": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Qldb integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_route53resolver": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Route53resolver integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_states": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws states integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_transit_gateway": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Transit Gateway integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_waf": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Waf integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_wafv2": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Wafv2 integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
-			"aws_auto_discovery": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Aws Auto Discovery Integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
 			"cloudfront": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -339,13 +185,6 @@ func resourceNewRelicCloudAwsIntegrations() *schema.Resource {
 				Elem:        cloudAwsIntegrationIamSchemaElem(),
 				MaxItems:    1,
 			},
-			"iot": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Iot integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
 			"kinesis": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -353,13 +192,6 @@ func resourceNewRelicCloudAwsIntegrations() *schema.Resource {
 				Elem:        cloudAwsIntegrationKinesisSchemaElem(),
 				MaxItems:    1,
 			},
-			"kinesis_firehose": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Kinesis Firehose integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
 			"lambda": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -388,13 +220,6 @@ func resourceNewRelicCloudAwsIntegrations() *schema.Resource {
 				Elem:        cloudAwsIntegrationRoute53SchemaElem(),
 				MaxItems:    1,
 			},
-			"ses": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Ses integration",
-				Elem:        cloudAwsIntegrationCommonSchemaElem(),
-				MaxItems:    1,
-			},
 			"sns": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -402,7 +227,7 @@ func resourceNewRelicCloudAwsIntegrations() *schema.Resource {
 				Elem:        cloudAwsIntegrationSnsSchemaElem(),
 				MaxItems:    1,
 			},
-		},
+		}, cloudAwsCatalogIntegrationsSchema()),
 	}
 }
 
@@ -411,21 +236,12 @@ func cloudAwsIntegrationSchemaBase() map[string]*schema.Schema {
 		"metrics_polling_interval": {
 			Type:        schema.TypeInt,
 			Optional:    true,
+			Computed:    true,
 			Description: "The data polling interval in seconds.",
 		},
 	}
 }
 
-// function to add common schema for various services.
-
-func cloudAwsIntegrationCommonSchemaElem() *schema.Resource {
-	s := cloudAwsIntegrationSchemaBaseExtended()
-
-	return &schema.Resource{
-		Schema: s,
-	}
-}
-
 func cloudAwsIntegrationSchemaBaseExtended() map[string]*schema.Schema {
 
 	return map[string]*schema.Schema{
@@ -440,6 +256,7 @@ func cloudAwsIntegrationSchemaBaseExtended() map[string]*schema.Schema {
 		"metrics_polling_interval": {
 			Type:        schema.TypeInt,
 			Optional:    true,
+			Computed:    true,
 			Description: "The data polling interval in seconds.",
 		},
 	}
@@ -464,6 +281,7 @@ func cloudAwsIntegrationSchemaBaseExtendedOne() map[string]*schema.Schema {
 		"metrics_polling_interval": {
 			Type:        schema.TypeInt,
 			Optional:    true,
+			Computed:    true,
 			Description: "The data polling interval in seconds.",
 		},
 		"tag_key": {
@@ -1155,9 +973,9 @@ func resourceNewRelicCloudAwsIntegrationsRead(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	flattenCloudAwsLinkedAccount(d, linkedAccount)
-
-	return nil
+	return readCloudIntegrations(d, cloudIntegrationsServiceKeys(resourceNewRelicCloudAwsIntegrations().Schema), func() {
+		flattenCloudAwsLinkedAccount(d, linkedAccount)
+	})
 }
 
 func resourceNewRelicCloudAwsIntegrationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
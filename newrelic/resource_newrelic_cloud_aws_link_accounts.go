@@ -0,0 +1,61 @@
+package newrelic
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+var cloudAwsLinkAccounts = cloudLinkAccountsProvider{
+	slug: "aws",
+	linkInput: func(d cloudLinkAccountsGetter, name string, arn string) cloud.CloudLinkCloudAccountsInput {
+		return cloud.CloudLinkCloudAccountsInput{
+			Aws: []cloud.CloudAwsLinkAccountInput{
+				{
+					Arn:                  arn,
+					MetricCollectionMode: cloud.CloudMetricCollectionMode(strings.ToUpper(d.Get("metric_collection_mode").(string))),
+					Name:                 name,
+				},
+			},
+		}
+	},
+	updateInput: func(d cloudLinkAccountsGetter, linkedAccountID int, name string, arn string) cloud.CloudUpdateCloudAccountsInput {
+		return cloud.CloudUpdateCloudAccountsInput{
+			Aws: []cloud.CloudAwsUpdateAccountInput{
+				{
+					Arn:             arn,
+					LinkedAccountId: linkedAccountID,
+					Name:            name,
+				},
+			},
+		}
+	},
+	value: func(linkedAccount *cloud.CloudLinkedAccount) string {
+		return linkedAccount.AuthLabel
+	},
+}
+
+func resourceNewRelicCloudAwsLinkAccounts() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: cloudAwsLinkAccounts.create,
+		ReadContext:   cloudAwsLinkAccounts.read,
+		UpdateContext: cloudAwsLinkAccounts.update,
+		DeleteContext: cloudAwsLinkAccounts.delete,
+		Schema: mergeSchemas(
+			cloudLinkAccountsSchema("A map of linked account names to the ARN of the IAM role of each AWS account."),
+			map[string]*schema.Schema{
+				"metric_collection_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PULL",
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{"PULL", "PUSH"}, false),
+					Description:  "How metrics of every account will be collected. Defaults to `PULL`.",
+				},
+			},
+		),
+		CustomizeDiff: cloudAwsLinkAccounts.customizeDiff,
+	}
+}
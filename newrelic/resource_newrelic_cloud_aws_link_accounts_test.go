@@ -0,0 +1,99 @@
+//go:build integration || CLOUD
+// +build integration CLOUD
+
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicCloudAwsLinkAccounts_Basic(t *testing.T) {
+	resourceName := "newrelic_cloud_aws_link_accounts.foo"
+	name := fmt.Sprintf("tf_cloud_aws_link_accounts_test_%s", acctest.RandString(5))
+
+	if subAccountIDExists := os.Getenv("NEW_RELIC_SUBACCOUNT_ID"); subAccountIDExists == "" {
+		t.Skipf("Skipping this test, as NEW_RELIC_SUBACCOUNT_ID must be set for this test to run.")
+	}
+
+	testAWSArn := os.Getenv("INTEGRATION_TESTING_AWS_ARN")
+	if testAWSArn == "" {
+		t.Skipf("INTEGRATION_TESTING_AWS_ARN must be set for this acceptance test")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccCloudLinkedAccountsCleanup(t, "aws") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicCloudAwsLinkAccountsDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicCloudAwsLinkAccountsConfig(name, testAWSArn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "linked_account_ids.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "linked_account_ids."+name),
+					resource.TestCheckResourceAttr(resourceName, "failed_accounts.%", "0"),
+				),
+			},
+			// Test: Remove the account from the set
+			{
+				Config: testAccNewRelicCloudAwsLinkAccountsConfig(name+"_updated", testAWSArn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "linked_account_ids.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "linked_account_ids."+name+"_updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNewRelicCloudAwsLinkAccountsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).NewClient
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_cloud_aws_link_accounts" {
+			continue
+		}
+
+		for key, value := range r.Primary.Attributes {
+			if !strings.HasPrefix(key, "linked_account_ids.") || key == "linked_account_ids.%" {
+				continue
+			}
+
+			linkedAccountID, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("error converting string id to int")
+			}
+
+			linkedAccount, err := client.Cloud.GetLinkedAccount(testSubAccountID, linkedAccountID)
+			if linkedAccount != nil && err == nil {
+				return fmt.Errorf("linked aws account %d still exists", linkedAccountID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccNewRelicCloudAwsLinkAccountsConfig(name string, arn string) string {
+	return fmt.Sprintf(`
+provider "newrelic" {
+  account_id = "%[1]d"
+  alias      = "cloud-integration-provider"
+}
+
+resource "newrelic_cloud_aws_link_accounts" "foo" {
+  provider   = newrelic.cloud-integration-provider
+  account_id = "%[1]d"
+  accounts = {
+    "%[2]s" = "%[3]s"
+  }
+}
+`, testSubAccountID, name, arn)
+}
@@ -0,0 +1,488 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+const (
+	cloudAwsOnboardingProfileAllSupported = "ALL_SUPPORTED"
+	cloudAwsOnboardingProfileComputeOnly  = "COMPUTE_ONLY"
+	cloudAwsOnboardingProfileCustom       = "CUSTOM"
+)
+
+// The services enabled by the COMPUTE_ONLY profile.
+var cloudAwsOnboardingComputeServices = []string{
+	"auto_scaling",
+	"ebs",
+	"ec2",
+	"ecs",
+	"elasticbeanstalk",
+	"lambda",
+}
+
+// The services whose data is not sent by CloudWatch metric streams, and still need to be
+// polled when the account is linked with the PUSH metric collection mode.
+var cloudAwsOnboardingMetricStreamsPolledServices = []string{
+	"billing",
+	"cloudtrail",
+	"health",
+	"trusted_advisor",
+	"x_ray",
+}
+
+func resourceNewRelicCloudAwsOnboarding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicCloudAwsOnboardingCreate,
+		ReadContext:   resourceNewRelicCloudAwsOnboardingRead,
+		UpdateContext: resourceNewRelicCloudAwsOnboardingUpdate,
+		DeleteContext: resourceNewRelicCloudAwsOnboardingDelete,
+		CustomizeDiff: resourceNewRelicCloudAwsOnboardingCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The New Relic account ID where you want to link the AWS account.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the linked account.",
+			},
+			"arn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The AWS role ARN.",
+			},
+			"metric_collection_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PULL",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PULL", "PUSH"}, false),
+				Description:  "How metrics will be collected. `PUSH` when metrics are sent by CloudWatch metric streams.",
+			},
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudAwsOnboardingProfileAllSupported,
+				ValidateFunc: validation.StringInSlice([]string{cloudAwsOnboardingProfileAllSupported, cloudAwsOnboardingProfileComputeOnly, cloudAwsOnboardingProfileCustom}, false),
+				Description:  "The services to enable. One of `ALL_SUPPORTED`, `COMPUTE_ONLY` or `CUSTOM`.",
+			},
+			"services": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The services to enable when `profile` is `CUSTOM`, named like the blocks of the `newrelic_cloud_aws_integrations` resource.",
+			},
+			"aws_regions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The AWS regions of the resources to monitor, for the services which can be filtered by region.",
+			},
+			"metrics_polling_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The data polling interval in seconds of the enabled services which accept it. Other services use their default interval.",
+			},
+			"linked_account_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the linked AWS account in New Relic.",
+			},
+			"enabled_services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The services enabled on the linked account, sorted alphabetically.",
+			},
+		},
+	}
+}
+
+// Works out the services to enable from the profile, and makes sure the services enabled
+// outside of Terraform are brought back in line with it.
+func resourceNewRelicCloudAwsOnboardingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("services") || !d.NewValueKnown("profile") {
+		return nil
+	}
+
+	services, err := resolveCloudAwsOnboardingServices(
+		d.Get("profile").(string),
+		d.Get("metric_collection_mode").(string),
+		expandStringSlice(d.Get("services").(*schema.Set).List()),
+	)
+	if err != nil {
+		return err
+	}
+
+	if interval := d.Get("metrics_polling_interval").(int); interval > 0 {
+		if err := validateCloudAwsOnboardingMetricsPollingInterval(services, interval); err != nil {
+			return err
+		}
+	}
+
+	old := expandStringSlice(d.Get("enabled_services").([]interface{}))
+	if d.Id() == "" || strings.Join(old, ",") != strings.Join(services, ",") {
+		return d.SetNew("enabled_services", services)
+	}
+
+	return nil
+}
+
+// Returns the sorted services to enable for a profile.
+func resolveCloudAwsOnboardingServices(profile string, metricCollectionMode string, custom []string) ([]string, error) {
+	supported := cloudAwsIntegrations(&cloud.CloudAwsIntegrationsInput{}, &cloud.CloudAwsDisableIntegrationsInput{})
+
+	var services []string
+
+	switch profile {
+	case cloudAwsOnboardingProfileCustom:
+		if len(custom) == 0 {
+			return nil, fmt.Errorf("`services` must be set when `profile` is %s", cloudAwsOnboardingProfileCustom)
+		}
+
+		for _, s := range custom {
+			if _, ok := supported[s]; !ok {
+				return nil, fmt.Errorf("unsupported service %q", s)
+			}
+		}
+		services = append(services, custom...)
+	case cloudAwsOnboardingProfileComputeOnly:
+		if len(custom) > 0 {
+			return nil, fmt.Errorf("`services` can only be set when `profile` is %s", cloudAwsOnboardingProfileCustom)
+		}
+		// The compute services are all sent by metric streams, so there would be nothing to poll.
+		if metricCollectionMode == "PUSH" {
+			return nil, fmt.Errorf("`profile` cannot be %s when `metric_collection_mode` is PUSH", cloudAwsOnboardingProfileComputeOnly)
+		}
+		services = append(services, cloudAwsOnboardingComputeServices...)
+	default:
+		if len(custom) > 0 {
+			return nil, fmt.Errorf("`services` can only be set when `profile` is %s", cloudAwsOnboardingProfileCustom)
+		}
+		if metricCollectionMode == "PUSH" {
+			services = append(services, cloudAwsOnboardingMetricStreamsPolledServices...)
+		} else {
+			for s := range supported {
+				services = append(services, s)
+			}
+		}
+	}
+
+	sort.Strings(services)
+
+	return services, nil
+}
+
+// The polling interval is only applied to the services which accept it, so it must be
+// accepted by at least one of the services.
+func validateCloudAwsOnboardingMetricsPollingInterval(services []string, interval int) error {
+	for _, s := range services {
+		if cloudAwsMetricsPollingIntervals(s).accepts(interval) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("`metrics_polling_interval` %d is not accepted by any of the services %v", interval, services)
+}
+
+// Builds the inputs enabling `services` and disabling `disabledServices` on a linked account.
+// The polling interval is only set on the services which accept it.
+func expandCloudAwsOnboardingIntegrationsInput(linkedAccountID int, services []string, disabledServices []string, awsRegions []interface{}, metricsPollingInterval int) (cloud.CloudIntegrationsInput, cloud.CloudDisableIntegrationsInput) {
+	enable := cloud.CloudAwsIntegrationsInput{}
+	disable := cloud.CloudAwsDisableIntegrationsInput{}
+	integrations := cloudAwsIntegrations(&enable, &disable)
+
+	for _, s := range services {
+		fun, ok := integrations[s]
+		if !ok {
+			continue
+		}
+
+		config := map[string]interface{}{}
+		if len(awsRegions) > 0 {
+			config["aws_regions"] = awsRegions
+		}
+		if metricsPollingInterval > 0 && cloudAwsMetricsPollingIntervals(s).accepts(metricsPollingInterval) {
+			config["metrics_polling_interval"] = metricsPollingInterval
+		}
+
+		fun.enableFunc([]interface{}{config}, linkedAccountID)
+	}
+
+	for _, s := range disabledServices {
+		if fun, ok := integrations[s]; ok {
+			fun.disableFunc(linkedAccountID)
+		}
+	}
+
+	return cloud.CloudIntegrationsInput{Aws: enable}, cloud.CloudDisableIntegrationsInput{Aws: disable}
+}
+
+func resourceNewRelicCloudAwsOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Linking AWS account %s", d.Get("arn").(string))
+
+	linkAccountInput := expandAwsCloudLinkAccountInput(d)
+
+	var diags diag.Diagnostics
+
+	retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		cloudLinkAccountPayload, err := client.Cloud.CloudLinkAccountWithContext(ctx, accountID, linkAccountInput)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, err := range cloudLinkAccountPayload.Errors {
+			if strings.Contains(err.Message, "The ARN you entered does not permit the correct access to your AWS account") {
+				return resource.RetryableError(fmt.Errorf("%s : %s", err.Type, err.Message))
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  err.Type + " " + err.Message,
+			})
+		}
+
+		if len(cloudLinkAccountPayload.LinkedAccounts) > 0 {
+			d.SetId(strconv.Itoa(cloudLinkAccountPayload.LinkedAccounts[0].ID))
+		}
+
+		return nil
+	})
+
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
+	}
+
+	if len(diags) > 0 {
+		return diags
+	}
+
+	if d.Id() == "" {
+		return diag.Errorf("the AWS account %s was not linked", d.Get("arn").(string))
+	}
+
+	linkedAccountID, _ := strconv.Atoi(d.Id())
+	services := expandStringSlice(d.Get("enabled_services").([]interface{}))
+
+	log.Printf("[INFO] Enabling the integrations %v of linked AWS account %d", services, linkedAccountID)
+
+	configureInput, _ := expandCloudAwsOnboardingIntegrationsInput(linkedAccountID, services, nil, d.Get("aws_regions").([]interface{}), d.Get("metrics_polling_interval").(int))
+	if diags := configureCloudAwsOnboardingIntegrations(ctx, providerConfig, accountID, configureInput); diags != nil {
+		return diags
+	}
+
+	return resourceNewRelicCloudAwsOnboardingRead(ctx, d, meta)
+}
+
+func configureCloudAwsOnboardingIntegrations(ctx context.Context, providerConfig *ProviderConfig, accountID int, input cloud.CloudIntegrationsInput) diag.Diagnostics {
+	payload, err := providerConfig.NewClient.Cloud.CloudConfigureIntegrationWithContext(ctx, accountID, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, err := range payload.Errors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Type + " " + err.Message,
+		})
+	}
+
+	return diags
+}
+
+func disableCloudAwsOnboardingIntegrations(ctx context.Context, providerConfig *ProviderConfig, accountID int, input cloud.CloudDisableIntegrationsInput) diag.Diagnostics {
+	payload, err := providerConfig.NewClient.Cloud.CloudDisableIntegrationWithContext(ctx, accountID, input)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, err := range payload.Errors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Type + " " + err.Message,
+		})
+	}
+
+	return diags
+}
+
+func resourceNewRelicCloudAwsOnboardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	linkedAccountID, convErr := strconv.Atoi(d.Id())
+	if convErr != nil {
+		return diag.FromErr(convErr)
+	}
+
+	linkedAccount, err := client.Cloud.GetLinkedAccountWithContext(ctx, accountID, linkedAccountID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	readAwsLinkedAccount(d, linkedAccount)
+	_ = d.Set("linked_account_id", linkedAccount.ID)
+
+	if err := d.Set("enabled_services", flattenCloudAwsOnboardingEnabledServices(linkedAccount)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Returns the sorted keys of the services enabled on a linked account.
+func flattenCloudAwsOnboardingEnabledServices(linkedAccount *cloud.CloudLinkedAccount) []string {
+	integrations := resourceNewRelicCloudAwsIntegrations().Data(nil)
+	flattenCloudAwsLinkedAccount(integrations, linkedAccount)
+
+	services := []string{}
+	for key := range cloudAwsIntegrations(&cloud.CloudAwsIntegrationsInput{}, &cloud.CloudAwsDisableIntegrationsInput{}) {
+		if _, ok := integrations.GetOk(key); ok {
+			services = append(services, key)
+		}
+	}
+	sort.Strings(services)
+
+	return services
+}
+
+func resourceNewRelicCloudAwsOnboardingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	linkedAccountID, convErr := strconv.Atoi(d.Id())
+	if convErr != nil {
+		return diag.FromErr(convErr)
+	}
+
+	if d.HasChange("name") {
+		payload, err := client.Cloud.CloudRenameAccountWithContext(ctx, accountID, []cloud.CloudRenameAccountsInput{
+			{
+				Name:            d.Get("name").(string),
+				LinkedAccountId: linkedAccountID,
+			},
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var diags diag.Diagnostics
+		for _, err := range payload.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  err.Type + " " + err.Message,
+			})
+		}
+		if len(diags) > 0 {
+			return diags
+		}
+	}
+
+	if d.HasChanges("enabled_services", "aws_regions", "metrics_polling_interval") {
+		o, n := d.GetChange("enabled_services")
+		oldServices := expandStringSlice(o.([]interface{}))
+		newServices := expandStringSlice(n.([]interface{}))
+
+		var removed []string
+		for _, s := range oldServices {
+			if !stringInSlice(newServices, s) {
+				removed = append(removed, s)
+			}
+		}
+
+		configureInput, disableInput := expandCloudAwsOnboardingIntegrationsInput(linkedAccountID, newServices, removed, d.Get("aws_regions").([]interface{}), d.Get("metrics_polling_interval").(int))
+
+		if len(removed) > 0 {
+			log.Printf("[INFO] Disabling the integrations %v of linked AWS account %d", removed, linkedAccountID)
+
+			if diags := disableCloudAwsOnboardingIntegrations(ctx, providerConfig, accountID, disableInput); diags != nil {
+				return diags
+			}
+		}
+
+		log.Printf("[INFO] Enabling the integrations %v of linked AWS account %d", newServices, linkedAccountID)
+
+		if diags := configureCloudAwsOnboardingIntegrations(ctx, providerConfig, accountID, configureInput); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceNewRelicCloudAwsOnboardingRead(ctx, d, meta)
+}
+
+func resourceNewRelicCloudAwsOnboardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	linkedAccountID, convErr := strconv.Atoi(d.Id())
+	if convErr != nil {
+		return diag.FromErr(convErr)
+	}
+
+	services := expandStringSlice(d.Get("enabled_services").([]interface{}))
+	if len(services) > 0 {
+		log.Printf("[INFO] Disabling the integrations %v of linked AWS account %d", services, linkedAccountID)
+
+		_, disableInput := expandCloudAwsOnboardingIntegrationsInput(linkedAccountID, nil, services, nil, 0)
+		if diags := disableCloudAwsOnboardingIntegrations(ctx, providerConfig, accountID, disableInput); diags != nil {
+			return diags
+		}
+	}
+
+	log.Printf("[INFO] Unlinking AWS account %d", linkedAccountID)
+
+	payload, err := client.Cloud.CloudUnlinkAccountWithContext(ctx, accountID, []cloud.CloudUnlinkAccountsInput{
+		{
+			LinkedAccountId: linkedAccountID,
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, err := range payload.Errors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Type + " " + err.Message,
+		})
+	}
+	if len(diags) > 0 {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
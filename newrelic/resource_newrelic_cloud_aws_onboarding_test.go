@@ -0,0 +1,95 @@
+//go:build integration || CLOUD
+// +build integration CLOUD
+
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicCloudAwsOnboarding_Basic(t *testing.T) {
+	resourceName := "newrelic_cloud_aws_onboarding.foo"
+	name := fmt.Sprintf("tf_cloud_aws_onboarding_test_%s", acctest.RandString(5))
+
+	if subAccountIDExists := os.Getenv("NEW_RELIC_SUBACCOUNT_ID"); subAccountIDExists == "" {
+		t.Skipf("Skipping this test, as NEW_RELIC_SUBACCOUNT_ID must be set for this test to run.")
+	}
+
+	testAWSArn := os.Getenv("INTEGRATION_TESTING_AWS_ARN")
+	if testAWSArn == "" {
+		t.Skipf("INTEGRATION_TESTING_AWS_ARN must be set for this acceptance test")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccCloudLinkedAccountsCleanup(t, "aws") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicCloudAwsOnboardingDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicCloudAwsOnboardingConfig(name, testAWSArn, `"lambda", "rds"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicCloudAwsLinkAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled_services.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_services.0", "lambda"),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccNewRelicCloudAwsOnboardingConfig(name+"_updated", testAWSArn, `"ec2"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name+"_updated"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_services.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_services.0", "ec2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNewRelicCloudAwsOnboardingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).NewClient
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_cloud_aws_onboarding" {
+			continue
+		}
+
+		resourceId, err := strconv.Atoi(r.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error converting string id to int")
+		}
+
+		linkedAccount, err := client.Cloud.GetLinkedAccount(testSubAccountID, resourceId)
+		if linkedAccount != nil && err == nil {
+			return fmt.Errorf("linked aws account still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccNewRelicCloudAwsOnboardingConfig(name string, arn string, services string) string {
+	return fmt.Sprintf(`
+provider "newrelic" {
+  account_id = "%[1]d"
+  alias      = "cloud-integration-provider"
+}
+
+resource "newrelic_cloud_aws_onboarding" "foo" {
+  provider    = newrelic.cloud-integration-provider
+  account_id  = "%[1]d"
+  name        = "%[2]s"
+  arn         = "%[3]s"
+  profile     = "CUSTOM"
+  services    = [%[4]s]
+  aws_regions = ["us-east-1"]
+}
+`, testSubAccountID, name, arn, services)
+}
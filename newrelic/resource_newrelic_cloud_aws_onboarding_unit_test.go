@@ -0,0 +1,85 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveCloudAwsOnboardingServices(t *testing.T) {
+	all, err := resolveCloudAwsOnboardingServices(cloudAwsOnboardingProfileAllSupported, "PULL", nil)
+	require.NoError(t, err)
+	require.Contains(t, all, "ec2")
+	require.Contains(t, all, "aws_athena")
+	require.IsIncreasing(t, all)
+
+	services, err := resolveCloudAwsOnboardingServices(cloudAwsOnboardingProfileAllSupported, "PUSH", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"billing", "cloudtrail", "health", "trusted_advisor", "x_ray"}, services)
+
+	services, err = resolveCloudAwsOnboardingServices(cloudAwsOnboardingProfileComputeOnly, "PULL", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"auto_scaling", "ebs", "ec2", "ecs", "elasticbeanstalk", "lambda"}, services)
+
+	services, err = resolveCloudAwsOnboardingServices(cloudAwsOnboardingProfileCustom, "PULL", []string{"rds", "lambda"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"lambda", "rds"}, services)
+
+	_, err = resolveCloudAwsOnboardingServices(cloudAwsOnboardingProfileCustom, "PULL", nil)
+	require.Error(t, err)
+
+	_, err = resolveCloudAwsOnboardingServices(cloudAwsOnboardingProfileCustom, "PULL", []string{"not_a_service"})
+	require.Error(t, err)
+
+	_, err = resolveCloudAwsOnboardingServices(cloudAwsOnboardingProfileComputeOnly, "PULL", []string{"rds"})
+	require.Error(t, err)
+
+	_, err = resolveCloudAwsOnboardingServices(cloudAwsOnboardingProfileComputeOnly, "PUSH", nil)
+	require.Error(t, err)
+}
+
+func TestValidateCloudAwsOnboardingMetricsPollingInterval(t *testing.T) {
+	require.NoError(t, validateCloudAwsOnboardingMetricsPollingInterval([]string{"billing", "ec2"}, 300))
+	require.NoError(t, validateCloudAwsOnboardingMetricsPollingInterval([]string{"billing", "ec2"}, 86400))
+	require.Error(t, validateCloudAwsOnboardingMetricsPollingInterval([]string{"billing", "ec2"}, 60))
+	require.Error(t, validateCloudAwsOnboardingMetricsPollingInterval([]string{"ec2"}, 42))
+}
+
+func TestExpandCloudAwsOnboardingIntegrationsInput(t *testing.T) {
+	configureInput, disableInput := expandCloudAwsOnboardingIntegrationsInput(
+		123,
+		[]string{"aws_athena", "billing"},
+		[]string{"ec2"},
+		[]interface{}{"us-east-1"},
+		900,
+	)
+
+	require.Equal(t, []cloud.CloudAwsAthenaIntegrationInput{{
+		LinkedAccountId:        123,
+		AwsRegions:             []string{"us-east-1"},
+		MetricsPollingInterval: 900,
+	}}, configureInput.Aws.AwsAthena)
+	require.Equal(t, []cloud.CloudBillingIntegrationInput{{
+		LinkedAccountId: 123,
+	}}, configureInput.Aws.Billing)
+	require.Empty(t, configureInput.Aws.Ec2)
+	require.Equal(t, []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: 123}}, disableInput.Aws.Ec2)
+	require.Empty(t, disableInput.Aws.AwsAthena)
+}
+
+func TestFlattenCloudAwsOnboardingEnabledServices(t *testing.T) {
+	services := flattenCloudAwsOnboardingEnabledServices(&cloud.CloudLinkedAccount{
+		ID: 123,
+		Integrations: []cloud.CloudIntegrationInterface{
+			&cloud.CloudLambdaIntegration{},
+			&cloud.CloudAwsAthenaIntegration{AwsRegions: []string{"us-east-1"}},
+			&cloud.CloudEc2Integration{},
+		},
+	})
+
+	require.Equal(t, []string{"aws_athena", "ec2", "lambda"}, services)
+}
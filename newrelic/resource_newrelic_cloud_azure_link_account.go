@@ -20,44 +20,67 @@ func resourceNewRelicCloudAzureLinkAccount() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
-			"account_id": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "The New Relic account ID where you want to link the Azure account.",
-				ForceNew:    true,
-			},
-			"application_id": {
-				Type:        schema.TypeString,
-				Description: "Application ID for Azure account",
-				Required:    true,
-				Sensitive:   true,
-			},
-			"client_secret": {
-				Type:        schema.TypeString,
-				Description: "Value of the client secret from Azure",
-				Required:    true,
-				Sensitive:   true,
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Description: "Name of the linked account",
-				Required:    true,
-			},
-			"subscription_id": {
-				Type:        schema.TypeString,
-				Description: "Subscription ID for the Azure account",
-				Required:    true,
-				Sensitive:   true,
-			},
-			"tenant_id": {
-				Type:        schema.TypeString,
-				Description: "Tenant ID for the Azure account",
-				Required:    true,
-				Sensitive:   true,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceNewRelicCloudAzureLinkAccountV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: migrateStateNewRelicCloudAzureLinkAccountV0toV1,
+				Version: 0,
 			},
 		},
+		Schema: resourceNewRelicCloudAzureLinkAccountSchema(),
+	}
+}
+
+// resourceNewRelicCloudAzureLinkAccountV0 kept `client_secret` in state as is.
+func resourceNewRelicCloudAzureLinkAccountV0() *schema.Resource {
+	s := resourceNewRelicCloudAzureLinkAccountSchema()
+	s["client_secret"].DiffSuppressFunc = nil
+
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
+func resourceNewRelicCloudAzureLinkAccountSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The New Relic account ID where you want to link the Azure account.",
+			ForceNew:    true,
+		},
+		"application_id": {
+			Type:        schema.TypeString,
+			Description: "Application ID for Azure account",
+			Required:    true,
+			Sensitive:   true,
+		},
+		"client_secret": {
+			Type:             schema.TypeString,
+			Description:      "Value of the client secret from Azure. Only an HMAC of it keyed on the linked account ID is kept in state.",
+			Required:         true,
+			Sensitive:        true,
+			DiffSuppressFunc: suppressCloudLinkAccountSecretDiff,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the linked account",
+			Required:    true,
+		},
+		"subscription_id": {
+			Type:        schema.TypeString,
+			Description: "Subscription ID for the Azure account",
+			Required:    true,
+			Sensitive:   true,
+		},
+		"tenant_id": {
+			Type:        schema.TypeString,
+			Description: "Tenant ID for the Azure account",
+			Required:    true,
+			Sensitive:   true,
+		},
 	}
 }
 
@@ -85,6 +108,7 @@ func resourceNewRelicCloudAzureLinkAccountCreate(ctx context.Context, d *schema.
 
 	if len(cloudLinkAccountPayload.LinkedAccounts) > 0 {
 		d.SetId(strconv.Itoa(cloudLinkAccountPayload.LinkedAccounts[0].ID))
+		setCloudLinkAccountSecret(d, "client_secret")
 	}
 	return diags
 }
@@ -96,8 +120,8 @@ func expandAzureCloudLinkAccountInput(d *schema.ResourceData) cloud.CloudLinkClo
 		azureAccount.ApplicationID = applicationID.(string)
 	}
 
-	if clientSecretID, ok := d.GetOk("client_secret"); ok {
-		azureAccount.ClientSecret = cloud.SecureValue(clientSecretID.(string))
+	if clientSecretID := getCloudLinkAccountSecret(d, "client_secret"); clientSecretID != "" {
+		azureAccount.ClientSecret = cloud.SecureValue(clientSecretID)
 	}
 
 	if name, ok := d.GetOk("name"); ok {
@@ -157,11 +181,13 @@ func resourceNewRelicCloudAzureLinkAccountUpdate(ctx context.Context, d *schema.
 	accountID := selectAccountID(providerConfig, d)
 	linkedAccountID, _ := strconv.Atoi(d.Id())
 
+	// The client secret is rotated in place, without unlinking the account, so that
+	// the configuration of its integrations is kept.
 	input := cloud.CloudUpdateCloudAccountsInput{
 		Azure: []cloud.CloudAzureUpdateAccountInput{
 			{
 				ApplicationID:   d.Get("application_id").(string),
-				ClientSecret:    cloud.SecureValue(d.Get("client_secret").(string)),
+				ClientSecret:    cloud.SecureValue(getCloudLinkAccountSecret(d, "client_secret")),
 				LinkedAccountId: linkedAccountID,
 				Name:            d.Get("name").(string),
 				SubscriptionId:  d.Get("subscription_id").(string),
@@ -179,6 +205,9 @@ func resourceNewRelicCloudAzureLinkAccountUpdate(ctx context.Context, d *schema.
 	if len(cloudUpdateAccountPayload.LinkedAccounts) == 0 {
 		return diag.FromErr(fmt.Errorf("no linked account with 'linked_account_id': %d found", linkedAccountID))
 	}
+
+	setCloudLinkAccountSecret(d, "client_secret")
+
 	return nil
 }
 
@@ -62,6 +62,7 @@ func TestAccNewRelicCloudAzureLinkAccount_Basic(t *testing.T) {
 				Config: testAccNewRelicAzureLinkAccountConfig(azureLinkAccountTestConfig, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNewRelicAzureLinkAccountExists(resourceName),
+					testAccCheckNewRelicCloudLinkAccountSecret(resourceName, "client_secret", testAzureClientSecretID),
 				),
 			},
 
@@ -164,3 +165,18 @@ resource "newrelic_cloud_azure_link_account" "foo" {
 }
 `)
 }
+
+func testAccCheckNewRelicCloudLinkAccountSecret(n string, key string, secret string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if expected := hmacCloudLinkAccountSecret(rs.Primary.ID, secret); rs.Primary.Attributes[key] != expected {
+			return fmt.Errorf("expected only the HMAC of %s in state, got %q", key, rs.Primary.Attributes[key])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+package newrelic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+var cloudAzureLinkAccounts = cloudLinkAccountsProvider{
+	slug:             "azure",
+	sharedAttributes: []string{"application_id", "client_secret", "tenant_id"},
+	secretAttributes: []string{"client_secret"},
+	linkInput: func(d cloudLinkAccountsGetter, name string, subscriptionID string) cloud.CloudLinkCloudAccountsInput {
+		return cloud.CloudLinkCloudAccountsInput{
+			Azure: []cloud.CloudAzureLinkAccountInput{
+				{
+					ApplicationID:  d.Get("application_id").(string),
+					ClientSecret:   cloud.SecureValue(getCloudLinkAccountSecret(d, "client_secret")),
+					Name:           name,
+					SubscriptionId: subscriptionID,
+					TenantId:       d.Get("tenant_id").(string),
+				},
+			},
+		}
+	},
+	updateInput: func(d cloudLinkAccountsGetter, linkedAccountID int, name string, subscriptionID string) cloud.CloudUpdateCloudAccountsInput {
+		return cloud.CloudUpdateCloudAccountsInput{
+			Azure: []cloud.CloudAzureUpdateAccountInput{
+				{
+					ApplicationID:   d.Get("application_id").(string),
+					ClientSecret:    cloud.SecureValue(getCloudLinkAccountSecret(d, "client_secret")),
+					LinkedAccountId: linkedAccountID,
+					Name:            name,
+					SubscriptionId:  subscriptionID,
+					TenantId:        d.Get("tenant_id").(string),
+				},
+			},
+		}
+	},
+	value: func(linkedAccount *cloud.CloudLinkedAccount) string {
+		return linkedAccount.ExternalId
+	},
+}
+
+func resourceNewRelicCloudAzureLinkAccounts() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: cloudAzureLinkAccounts.create,
+		ReadContext:   cloudAzureLinkAccounts.read,
+		UpdateContext: cloudAzureLinkAccounts.update,
+		DeleteContext: cloudAzureLinkAccounts.delete,
+		Schema: mergeSchemas(
+			cloudLinkAccountsSchema("A map of linked account names to the ID of each Azure subscription."),
+			map[string]*schema.Schema{
+				"application_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					Description: "The ID of the Azure application shared by every subscription.",
+				},
+				"client_secret": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Sensitive:        true,
+					DiffSuppressFunc: suppressCloudLinkAccountSecretDiff,
+					Description:      "The value of the client secret of the Azure application. Only an HMAC of it keyed on the ID of the set is kept in state.",
+				},
+				"tenant_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					Description: "The ID of the Azure tenant of the subscriptions.",
+				},
+			},
+		),
+		CustomizeDiff: cloudAzureLinkAccounts.customizeDiff,
+	}
+}
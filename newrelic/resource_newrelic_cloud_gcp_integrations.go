@@ -17,7 +17,7 @@ func resourceNewrelicCloudGcpIntegrations() *schema.Resource {
 		UpdateContext: resourceNewrelicCloudGcpIntegrationsUpdate,
 		DeleteContext: resourceNewrelicCloudGcpIntegrationsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importCloudIntegrations,
 		},
 		Schema: generateGcpIntegrationSchema(),
 	}
@@ -56,7 +56,7 @@ func generateGcpIntegrationSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Description: "GCP big query service",
 			Optional:    true,
-			Elem:        cloudGCPIntegrationMergeSchema(),
+			Elem:        cloudGcpIntegrationBigQueryElem(),
 			MaxItems:    1,
 		},
 		"big_table": {
@@ -230,6 +230,7 @@ func cloudGcpIntegrationSchemaBase() map[string]*schema.Schema {
 			Type:        schema.TypeInt,
 			Description: "the data polling interval in seconds",
 			Optional:    true,
+			Computed:    true,
 		},
 	}
 }
@@ -256,6 +257,20 @@ func cloudGCPIntegrationMergeSchema() *schema.Resource {
 	}
 }
 
+// function to add the big query specific schema to the merged schema of gcp resources
+func cloudGcpIntegrationBigQueryElem() *schema.Resource {
+	s := cloudGCPIntegrationMergeSchema().Schema
+	s["fetch_table_metrics"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "to fetch the metrics of every table of the datasets",
+		Optional:    true,
+	}
+
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
 func resourceNewrelicCloudGcpIntegrationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
@@ -512,6 +527,9 @@ func expandCloudGcpBigQueryIntegrationsinputs(b []interface{}, linkedAccountID i
 		if f, ok := in["fetch_tags"]; ok {
 			input.FetchTags = f.(bool)
 		}
+		if f, ok := in["fetch_table_metrics"]; ok {
+			input.FetchTableMetrics = f.(bool)
+		}
 		expanded[i] = input
 	}
 	return expanded
@@ -1004,8 +1022,9 @@ func resourceNewrelicCloudGcpIntegrationsRead(ctx context.Context, d *schema.Res
 		}
 		return diag.FromErr(err)
 	}
-	flattenCloudGcpLinkedAccount(d, linkedAccount)
-	return nil
+	return readCloudIntegrations(d, cloudIntegrationsServiceKeys(resourceNewrelicCloudGcpIntegrations().Schema), func() {
+		flattenCloudGcpLinkedAccount(d, linkedAccount)
+	})
 }
 
 // flatten function to set(store) outputs from the terraform apply
@@ -1080,6 +1099,7 @@ func flattenCloudGcpCommonIntegration(in interface{}) []interface{} {
 
 	switch t := in.(type) {
 	case *cloud.CloudGcpBigqueryIntegration:
+		out["fetch_table_metrics"] = t.FetchTableMetrics
 		out["fetch_tags"] = t.FetchTags
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
 	case *cloud.CloudGcpPubsubIntegration:
@@ -1091,21 +1111,21 @@ func flattenCloudGcpCommonIntegration(in interface{}) []interface{} {
 	case *cloud.CloudGcpStorageIntegration:
 		out["fetch_tags"] = t.FetchTags
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
-	case cloud.CloudGcpAlloydbIntegration:
+	case *cloud.CloudGcpAlloydbIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
-	case cloud.CloudGcpAppengineIntegration:
+	case *cloud.CloudGcpAppengineIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
-	case cloud.CloudGcpBigtableIntegration:
+	case *cloud.CloudGcpBigtableIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
-	case cloud.CloudGcpComposerIntegration:
+	case *cloud.CloudGcpComposerIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
-	case cloud.CloudGcpDataflowIntegration:
+	case *cloud.CloudGcpDataflowIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
-	case cloud.CloudGcpDataprocIntegration:
+	case *cloud.CloudGcpDataprocIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
 	case *cloud.CloudGcpDatastoreIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
-	case cloud.CloudGcpFirebasedatabaseIntegration:
+	case *cloud.CloudGcpFirebasedatabaseIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
 	case *cloud.CloudGcpFirebasehostingIntegration:
 		out["metrics_polling_interval"] = t.MetricsPollingInterval
@@ -144,6 +144,7 @@ resource "newrelic_cloud_gcp_integrations" "foo1" {
   big_query {
     metrics_polling_interval = 400
     fetch_tags               = true
+    fetch_table_metrics      = true
   }
   big_table {
     metrics_polling_interval = 400
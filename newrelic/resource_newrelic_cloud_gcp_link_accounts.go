@@ -0,0 +1,45 @@
+package newrelic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+var cloudGcpLinkAccounts = cloudLinkAccountsProvider{
+	slug: "gcp",
+	linkInput: func(d cloudLinkAccountsGetter, name string, projectID string) cloud.CloudLinkCloudAccountsInput {
+		return cloud.CloudLinkCloudAccountsInput{
+			Gcp: []cloud.CloudGcpLinkAccountInput{
+				{
+					Name:      name,
+					ProjectId: projectID,
+				},
+			},
+		}
+	},
+	updateInput: func(d cloudLinkAccountsGetter, linkedAccountID int, name string, projectID string) cloud.CloudUpdateCloudAccountsInput {
+		return cloud.CloudUpdateCloudAccountsInput{
+			Gcp: []cloud.CloudGcpUpdateAccountInput{
+				{
+					LinkedAccountId: linkedAccountID,
+					Name:            name,
+					ProjectId:       projectID,
+				},
+			},
+		}
+	},
+	value: func(linkedAccount *cloud.CloudLinkedAccount) string {
+		return linkedAccount.ExternalId
+	},
+}
+
+func resourceNewRelicCloudGcpLinkAccounts() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: cloudGcpLinkAccounts.create,
+		ReadContext:   cloudGcpLinkAccounts.read,
+		UpdateContext: cloudGcpLinkAccounts.update,
+		DeleteContext: cloudGcpLinkAccounts.delete,
+		Schema:        cloudLinkAccountsSchema("A map of linked account names to the ID of each GCP project."),
+		CustomizeDiff: cloudGcpLinkAccounts.customizeDiff,
+	}
+}
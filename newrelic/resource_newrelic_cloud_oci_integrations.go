@@ -0,0 +1,267 @@
+package newrelic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+func resourceNewRelicCloudOciIntegrations() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicCloudOciIntegrationsCreate,
+		ReadContext:   resourceNewRelicCloudOciIntegrationsRead,
+		UpdateContext: resourceNewRelicCloudOciIntegrationsUpdate,
+		DeleteContext: resourceNewRelicCloudOciIntegrationsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: generateOciIntegrationSchema(),
+	}
+}
+
+func generateOciIntegrationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Type:        schema.TypeInt,
+			Description: "ID of the newrelic account",
+			Computed:    true,
+			Optional:    true,
+		},
+		"linked_account_id": {
+			Type:        schema.TypeInt,
+			Description: "Id of the linked oci account in New Relic",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"oci_metadata_and_tags": {
+			Type:        schema.TypeList,
+			Description: "OCI metadata and tags integration",
+			Optional:    true,
+			Elem:        cloudOciIntegrationSchema(),
+			MaxItems:    1,
+		},
+		"oci_logs": {
+			Type:        schema.TypeList,
+			Description: "OCI logs integration",
+			Optional:    true,
+			Elem:        cloudOciIntegrationSchema(),
+			MaxItems:    1,
+		},
+	}
+}
+
+// common schema of the oci integrations; NerdGraph only allows enabling and disabling
+// them, so the polling interval and the stacks set up when linking the tenancy are exported
+// but cannot be configured.
+func cloudOciIntegrationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"metrics_polling_interval": {
+				Type:        schema.TypeInt,
+				Description: "the data polling interval in seconds",
+				Computed:    true,
+			},
+			"metric_stacks": {
+				Type:        schema.TypeList,
+				Description: "the metrics OCI stack IDs",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"logging_stacks": {
+				Type:        schema.TypeList,
+				Description: "the logging OCI stack IDs",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNewRelicCloudOciIntegrationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	cloudOciIntegrationInputs, _ := expandCloudOciIntegrationsInputs(d)
+	ociIntegrationsPayload, err := client.Cloud.CloudConfigureIntegrationWithContext(ctx, accountID, cloudOciIntegrationInputs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var diags diag.Diagnostics
+	if len(ociIntegrationsPayload.Errors) > 0 {
+		for _, err := range ociIntegrationsPayload.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  err.Type + " " + err.Message,
+			})
+		}
+		return diags
+	}
+	if len(ociIntegrationsPayload.Integrations) > 0 {
+		d.SetId(strconv.Itoa(d.Get("linked_account_id").(int)))
+	}
+	return resourceNewRelicCloudOciIntegrationsRead(ctx, d, meta)
+}
+
+// expand function to extract inputs for cloud integrations from the schema
+func expandCloudOciIntegrationsInputs(d *schema.ResourceData) (cloud.CloudIntegrationsInput, cloud.CloudDisableIntegrationsInput) {
+	ociCloudIntegrations := cloud.CloudOciIntegrationsInput{}
+	ociDisableIntegrations := cloud.CloudOciDisableIntegrationsInput{}
+	var linkedAccountID int
+	if lid, ok := d.GetOk("linked_account_id"); ok {
+		linkedAccountID = lid.(int)
+	}
+	if _, ok := d.GetOk("oci_metadata_and_tags"); ok {
+		ociCloudIntegrations.OciMetadataAndTags = []cloud.CloudOciMetadataAndTagsIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	} else if o, n := d.GetChange("oci_metadata_and_tags"); len(n.([]interface{})) < len(o.([]interface{})) {
+		ociDisableIntegrations.OciMetadataAndTags = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	}
+	if _, ok := d.GetOk("oci_logs"); ok {
+		ociCloudIntegrations.OciLogs = []cloud.CloudOciLogsIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	} else if o, n := d.GetChange("oci_logs"); len(n.([]interface{})) < len(o.([]interface{})) {
+		ociDisableIntegrations.OciLogs = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	}
+	configureInput := cloud.CloudIntegrationsInput{
+		Oci: ociCloudIntegrations,
+	}
+	disableInput := cloud.CloudDisableIntegrationsInput{
+		Oci: ociDisableIntegrations,
+	}
+	return configureInput, disableInput
+}
+
+func resourceNewRelicCloudOciIntegrationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	linkedAccountID, convErr := strconv.Atoi(d.Id())
+
+	if convErr != nil {
+		return diag.FromErr(convErr)
+	}
+
+	linkedAccount, err := client.Cloud.GetLinkedAccountWithContext(ctx, accountID, linkedAccountID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	flattenCloudOciLinkedAccount(d, linkedAccount)
+	return nil
+}
+
+// flatten function to set(store) outputs from the terraform apply
+func flattenCloudOciLinkedAccount(d *schema.ResourceData, linkedAccount *cloud.CloudLinkedAccount) {
+	_ = d.Set("account_id", linkedAccount.NrAccountId)
+	_ = d.Set("linked_account_id", linkedAccount.ID)
+	for _, i := range linkedAccount.Integrations {
+		switch t := i.(type) {
+		case *cloud.CloudOciMetadataAndTagsIntegration:
+			_ = d.Set("oci_metadata_and_tags", flattenCloudOciCommonIntegration(t.MetricsPollingInterval, t.MetricStacks, t.LoggingStacks))
+		case *cloud.CloudOciLogsIntegration:
+			_ = d.Set("oci_logs", flattenCloudOciCommonIntegration(t.MetricsPollingInterval, t.MetricStacks, t.LoggingStacks))
+		}
+	}
+}
+
+func flattenCloudOciCommonIntegration(metricsPollingInterval int, metricStacks []string, loggingStacks []string) []interface{} {
+	flattened := make([]interface{}, 1)
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = metricsPollingInterval
+	out["metric_stacks"] = metricStacks
+	out["logging_stacks"] = loggingStacks
+
+	flattened[0] = out
+	return flattened
+}
+
+func resourceNewRelicCloudOciIntegrationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	configureInput, disableInput := expandCloudOciIntegrationsInputs(d)
+	cloudDisableIntegrationsPayload, err := client.Cloud.CloudDisableIntegrationWithContext(ctx, accountID, disableInput)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+
+	if len(cloudDisableIntegrationsPayload.Errors) > 0 {
+		for _, err := range cloudDisableIntegrationsPayload.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  err.Type + " " + err.Message,
+			})
+		}
+		return diags
+	}
+	cloudOciIntegrationsPayload, err := client.Cloud.CloudConfigureIntegrationWithContext(ctx, accountID, configureInput)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(cloudOciIntegrationsPayload.Errors) > 0 {
+		for _, err := range cloudOciIntegrationsPayload.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  err.Type + " " + err.Message,
+			})
+		}
+		return diags
+	}
+	return resourceNewRelicCloudOciIntegrationsRead(ctx, d, meta)
+}
+
+func resourceNewRelicCloudOciIntegrationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	deleteInput := expandCloudOciDisableInputs(d)
+	ociDisablePayload, err := client.Cloud.CloudDisableIntegrationWithContext(ctx, accountID, deleteInput)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+
+	if len(ociDisablePayload.Errors) > 0 {
+		for _, err := range ociDisablePayload.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  err.Type + " " + err.Message,
+			})
+		}
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// expand function to extract the inputs values from the schema for disabling the integration for particular services
+func expandCloudOciDisableInputs(d *schema.ResourceData) cloud.CloudDisableIntegrationsInput {
+	cloudOciDisableInput := cloud.CloudOciDisableIntegrationsInput{}
+	var linkedAccountID int
+	if l, ok := d.GetOk("linked_account_id"); ok {
+		linkedAccountID = l.(int)
+	}
+	if _, ok := d.GetOk("oci_metadata_and_tags"); ok {
+		cloudOciDisableInput.OciMetadataAndTags = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	}
+	if _, ok := d.GetOk("oci_logs"); ok {
+		cloudOciDisableInput.OciLogs = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: linkedAccountID}}
+	}
+	deleteInput := cloud.CloudDisableIntegrationsInput{
+		Oci: cloudOciDisableInput,
+	}
+	return deleteInput
+}
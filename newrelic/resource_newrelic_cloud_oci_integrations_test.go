@@ -0,0 +1,123 @@
+//go:build integration || CLOUD
+// +build integration CLOUD
+
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicCloudOciIntegrations_Basic(t *testing.T) {
+	resourceName := "newrelic_cloud_oci_integrations.foo"
+	testOciIntegrationName := fmt.Sprintf("tf_cloud_integrations_test_oci_%s", acctest.RandString(5))
+
+	if subAccountIDExists := os.Getenv("NEW_RELIC_SUBACCOUNT_ID"); subAccountIDExists == "" {
+		t.Skipf("Skipping this test, as NEW_RELIC_SUBACCOUNT_ID must be set for this test to run.")
+	}
+
+	OciIntegrationTestConfig := map[string]string{
+		"name":       testOciIntegrationName,
+		"account_id": strconv.Itoa(testSubAccountID),
+	}
+
+	for key, envVar := range map[string]string{
+		"tenant_id":         "INTEGRATION_TESTING_OCI_TENANT_ID",
+		"compartment_ocid":  "INTEGRATION_TESTING_OCI_COMPARTMENT_OCID",
+		"oci_client_id":     "INTEGRATION_TESTING_OCI_CLIENT_ID",
+		"oci_client_secret": "INTEGRATION_TESTING_OCI_CLIENT_SECRET",
+		"oci_domain_url":    "INTEGRATION_TESTING_OCI_DOMAIN_URL",
+		"oci_home_region":   "INTEGRATION_TESTING_OCI_HOME_REGION",
+		"ingest_vault_ocid": "INTEGRATION_TESTING_OCI_INGEST_VAULT_OCID",
+		"user_vault_ocid":   "INTEGRATION_TESTING_OCI_USER_VAULT_OCID",
+	} {
+		value := os.Getenv(envVar)
+		if value == "" {
+			t.Skipf("%s must be set for this acceptance test", envVar)
+		}
+		OciIntegrationTestConfig[key] = value
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccCloudLinkedAccountsCleanup(t, "oci") },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicCloudOciLinkAccountDestroy,
+		Steps: []resource.TestStep{
+			//Test: Create
+			{
+				Config: testAccNewRelicCloudOciIntegrationsConfig(OciIntegrationTestConfig, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicCloudOciIntegrationsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oci_metadata_and_tags.#", "1"),
+				),
+			},
+			//Test: Update
+			{
+				Config: testAccNewRelicCloudOciIntegrationsConfig(OciIntegrationTestConfig, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicCloudOciIntegrationsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oci_logs.#", "1"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNewRelicCloudOciIntegrationsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+		resourceId, err := strconv.Atoi(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error converting string to int")
+		}
+
+		linkedAccount, err := client.Cloud.GetLinkedAccount(testSubAccountID, resourceId)
+		if err != nil {
+			return err
+		}
+
+		if len(linkedAccount.Integrations) == 0 {
+			return fmt.Errorf("An error occurred creating OCI integrations")
+		}
+
+		return nil
+	}
+}
+
+func testAccNewRelicCloudOciIntegrationsConfig(OciIntegrationTestConfig map[string]string, updated bool) string {
+	logs := ""
+	if updated {
+		logs = `
+  oci_logs {}`
+	}
+
+	return testAccNewRelicOciLinkAccountConfig(OciIntegrationTestConfig, false) + fmt.Sprintf(`
+
+resource "newrelic_cloud_oci_integrations" "foo" {
+  provider          = newrelic.cloud-integration-provider
+  account_id        = "%s"
+  linked_account_id = newrelic_cloud_oci_link_account.foo.id
+
+  oci_metadata_and_tags {}%s
+}
+`, OciIntegrationTestConfig["account_id"], logs)
+}
@@ -0,0 +1,187 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entityrelationship"
+)
+
+func resourceNewRelicEntityRelationship() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicEntityRelationshipCreate,
+		ReadContext:   resourceNewRelicEntityRelationshipRead,
+		DeleteContext: resourceNewRelicEntityRelationshipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"source_entity_guid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The GUID of the source entity of the relationship.",
+			},
+			"target_entity_guid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The GUID of the target entity of the relationship.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(listValidEntityRelationshipTypes(), false),
+				Description:  "The type of the relationship, e.g. CALLS or CONTAINS.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Second),
+		},
+	}
+}
+
+type entityRelationshipID struct {
+	source string
+	target string
+	edge   string
+}
+
+func (id entityRelationshipID) String() string {
+	return fmt.Sprintf("%s:%s:%s", id.source, id.target, id.edge)
+}
+
+func parseEntityRelationshipID(id string) (entityRelationshipID, error) {
+	split := strings.Split(id, ":")
+	if len(split) != 3 {
+		return entityRelationshipID{}, fmt.Errorf("invalid entity relationship ID %s, expected <source_entity_guid>:<target_entity_guid>:<type>", id)
+	}
+
+	return entityRelationshipID{source: split[0], target: split[1], edge: split[2]}, nil
+}
+
+func resourceNewRelicEntityRelationshipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	id := entityRelationshipID{
+		source: d.Get("source_entity_guid").(string),
+		target: d.Get("target_entity_guid").(string),
+		edge:   d.Get("type").(string),
+	}
+
+	log.Printf("[INFO] Creating New Relic entity relationship %s", id)
+
+	res, err := client.EntityRelationship.EntityRelationshipUserDefinedCreateOrReplaceWithContext(ctx, common.EntityGUID(id.source), common.EntityGUID(id.target), entityrelationship.EntityRelationshipEdgeType(id.edge))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if res != nil && len(res.Errors) > 0 {
+		var diags diag.Diagnostics
+		for _, e := range res.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  e.Message + ": " + string(e.Type),
+			})
+		}
+		return diags
+	}
+
+	d.SetId(id.String())
+
+	// Relationships are indexed asynchronously.
+	retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		found, err := findEntityRelationship(ctx, meta, id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if !found {
+			return resource.RetryableError(fmt.Errorf("expected entity relationship %s to have been created but was not found", id))
+		}
+
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
+	}
+
+	return resourceNewRelicEntityRelationshipRead(ctx, d, meta)
+}
+
+func resourceNewRelicEntityRelationshipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Reading New Relic entity relationship %s", d.Id())
+
+	id, err := parseEntityRelationshipID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	found, err := findEntityRelationship(ctx, meta, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("source_entity_guid", id.source)
+	_ = d.Set("target_entity_guid", id.target)
+	_ = d.Set("type", id.edge)
+
+	return nil
+}
+
+func resourceNewRelicEntityRelationshipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Deleting New Relic entity relationship %s", d.Id())
+
+	id, err := parseEntityRelationshipID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.EntityRelationship.EntityRelationshipUserDefinedDeleteWithContext(ctx, common.EntityGUID(id.source), common.EntityGUID(id.target), entityrelationship.EntityRelationshipEdgeType(id.edge))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if res != nil && len(res.Errors) > 0 {
+		var diags diag.Diagnostics
+		for _, e := range res.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  e.Message + ": " + string(e.Type),
+			})
+		}
+		return diags
+	}
+
+	return nil
+}
+
+// Returns whether the user defined relationship exists among the relationships of its source.
+func findEntityRelationship(ctx context.Context, meta interface{}, id entityRelationshipID) (bool, error) {
+	edges, ok, err := getEntityRelationships(ctx, meta.(*ProviderConfig).NewClient, id.source)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	for _, e := range edges {
+		if e.userDefined() && e.Source.GUID == id.source && e.Target.GUID == id.target && e.Type == id.edge {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,110 @@
+//go:build integration || ENTITY
+// +build integration ENTITY
+
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicEntityRelationship_Basic(t *testing.T) {
+	resourceName := "newrelic_entity_relationship.foo"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicEntityRelationshipDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicEntityRelationshipConfig(rName, "CONTAINS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "CONTAINS"),
+				),
+			},
+			// Test: Traverse
+			{
+				Config: testAccNewRelicEntityRelationshipConfig(rName, "CONTAINS") + `
+data "newrelic_entity_relationships" "foo" {
+	guid              = newrelic_workload.source.guid
+	direction         = "outgoing"
+	user_defined_only = true
+	depends_on        = [newrelic_entity_relationship.foo]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.newrelic_entity_relationships.foo", "entities.#", "1"),
+					resource.TestCheckResourceAttrPair("data.newrelic_entity_relationships.foo", "entity_guids.0", "newrelic_workload.target", "guid"),
+				),
+			},
+			// Test: Replace
+			{
+				Config: testAccNewRelicEntityRelationshipConfig(rName, "CALLS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "CALLS"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNewRelicEntityRelationshipConfig(name string, edgeType string) string {
+	return fmt.Sprintf(`
+resource "newrelic_workload" "source" {
+	name       = "%[2]s source"
+	account_id = %[1]d
+	entity_search_query {
+		query = "name = '%[2]s source'"
+	}
+}
+
+resource "newrelic_workload" "target" {
+	name       = "%[2]s target"
+	account_id = %[1]d
+	entity_search_query {
+		query = "name = '%[2]s target'"
+	}
+}
+
+resource "newrelic_entity_relationship" "foo" {
+	source_entity_guid = newrelic_workload.source.guid
+	target_entity_guid = newrelic_workload.target.guid
+	type               = "%[3]s"
+}
+`, testAccountID, name, edgeType)
+}
+
+func testAccCheckNewRelicEntityRelationshipDestroy(s *terraform.State) error {
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_entity_relationship" {
+			continue
+		}
+
+		id, err := parseEntityRelationshipID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := findEntityRelationship(context.Background(), testAccProvider.Meta(), id)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("entity relationship %s still exists", r.Primary.ID)
+		}
+	}
+
+	return nil
+}
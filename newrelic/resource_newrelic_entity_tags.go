@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
 	nrErrors "github.com/newrelic/newrelic-client-go/v2/pkg/errors"
@@ -31,6 +33,7 @@ func resourceNewRelicEntityTags() *schema.Resource {
 		ReadContext:   resourceNewRelicEntityTagsRead,
 		UpdateContext: resourceNewRelicEntityTagsUpdate,
 		DeleteContext: resourceNewRelicEntityTagsDelete,
+		CustomizeDiff: validateEntityTagsKeys,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -41,6 +44,13 @@ func resourceNewRelicEntityTags() *schema.Resource {
 				ForceNew:    true,
 				Description: "The guid of the entity to tag.",
 			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      entityTagsModeAuthoritative,
+				ValidateFunc: validation.StringInSlice(listValidEntityTagsModes(), false),
+				Description:  fmt.Sprintf("How the tags of the entity are managed. One of: (%s).", strings.Join(listValidEntityTagsModes(), ", ")),
+			},
 			"tag": {
 				Type:        schema.TypeSet,
 				MinItems:    1,
@@ -66,67 +76,35 @@ func resourceNewRelicEntityTags() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(180 * time.Second),
+			Update: schema.DefaultTimeout(180 * time.Second),
 		},
 	}
 }
 
-func resourceNewRelicEntityTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
-
-	guid := common.EntityGUID(d.Get("guid").(string))
-	tags := expandEntityTags(d.Get("tag").(*schema.Set).List())
-
-	res, err := client.Entities.TaggingAddTagsToEntityWithContext(ctx, guid, tags)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if res != nil && len(res.Errors) > 0 {
-		return handleEntityTagsMutationEmbeddedErrors(res)
-	}
-	d.SetId(string(guid))
-
-	retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		t, err := client.Entities.GetTagsForEntityMutable(guid)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("error retrieving entity tags for guid %s: %s", d.Id(), err))
-		}
-
-		currentTags := convertTagTypes(t)
-
-		for _, t := range tags {
-			var tag *entities.TaggingTagInput
-			if tag = getTag(currentTags, t.Key); tag == nil {
-				return resource.RetryableError(fmt.Errorf("expected entity tag %s to have been created but was not found", t.Key))
-			}
-
-			if ok := tagValuesExist(tag, t.Values); !ok {
-				return resource.RetryableError(fmt.Errorf("expected entity tag values %s to have been created for tag %s but were not found", t.Values, t.Key))
-			}
-		}
-
-		diag := resourceNewRelicEntityTagsRead(ctx, d, meta)
-		if diag.HasError() {
-			return resource.RetryableError(errors.New("error reading tag values after creation"))
+// Reserved tags are set by New Relic and ignored on read, so declaring one would never converge.
+func validateEntityTagsKeys(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, t := range expandEntityTags(d.Get("tag").(*schema.Set).List()) {
+		if stringInSlice(defaultTags, t.Key) {
+			return fmt.Errorf("tag key '%s' is a reserved key and cannot be managed", t.Key)
 		}
-
-		return nil
-	})
-
-	if retryErr != nil {
-		return diag.FromErr(retryErr)
 	}
 
 	return nil
 }
 
+func resourceNewRelicEntityTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Creating New Relic entity tags for entity guid %s", d.Get("guid").(string))
+
+	return applyEntityTags(ctx, d, meta, true, d.Timeout(schema.TimeoutCreate))
+}
+
 func resourceNewRelicEntityTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
 
 	log.Printf("[INFO] Reading New Relic entity tags for entity guid %s", d.Id())
 
-	t, err := client.Entities.GetTagsForEntityMutable(common.EntityGUID(d.Id()))
+	t, err := client.Entities.GetTagsForEntityWithContextMutable(ctx, common.EntityGUID(d.Id()))
 
 	if err != nil {
 		if _, ok := err.(*nrErrors.NotFound); ok {
@@ -137,48 +115,101 @@ func resourceNewRelicEntityTagsRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	tags := convertTagTypes(t)
+	// Imported resources don't have a mode yet.
+	mode := d.Get("mode").(string)
+	if mode == "" {
+		mode = entityTagsModeAuthoritative
+		if err := d.Set("mode", mode); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	declared := expandEntityTags(d.Get("tag").(*schema.Set).List())
+	tags := filterEntityTagsForMode(mode, convertTagTypes(t), declared)
 
 	return diag.FromErr(flattenEntityTags(d, tags))
 }
 
 func resourceNewRelicEntityTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Updating New Relic entity tags for entity guid %s", d.Id())
+
+	return applyEntityTags(ctx, d, meta, false, d.Timeout(schema.TimeoutUpdate))
+}
+
+// Applies the declared tags to the entity in the mode of the resource, then waits for the
+// tags of the entity to reflect them, as tags are updated asynchronously. On create, the
+// authoritative mode only adds the declared tags, as it always has: the tags already on
+// the entity show up in the next plan, and are replaced by the next update.
+func applyEntityTags(ctx context.Context, d *schema.ResourceData, meta interface{}, create bool, timeout time.Duration) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
 
-	log.Printf("[INFO] Updating New Relic entity tags for entity guid %s", d.Id())
-
+	guid := common.EntityGUID(d.Get("guid").(string))
+	mode := d.Get("mode").(string)
 	tags := expandEntityTags(d.Get("tag").(*schema.Set).List())
-	res, err := client.Entities.TaggingReplaceTagsOnEntityWithContext(ctx, common.EntityGUID(d.Id()), tags)
+	removedKeys := []string{}
+
+	if create && mode == entityTagsModeAuthoritative {
+		mode = entityTagsModeAdditive
+	}
+
+	var res *entities.TaggingMutationResult
+	var err error
+
+	switch mode {
+	case entityTagsModeAdditive:
+		res, err = client.Entities.TaggingAddTagsToEntityWithContext(ctx, guid, tags)
+	case entityTagsModeKeyAuthoritative:
+		t, getErr := client.Entities.GetTagsForEntityWithContextMutable(ctx, guid)
+		if getErr != nil {
+			return diag.FromErr(fmt.Errorf("error retrieving entity tags for guid %s: %s", guid, getErr))
+		}
+
+		o, _ := d.GetChange("tag")
+		previous := expandEntityTags(o.(*schema.Set).List())
+
+		var add []entities.TaggingTagInput
+		removedKeys, add = planEntityTagsKeyAuthoritative(convertTagTypes(t), tags, previous)
+
+		if len(removedKeys) > 0 {
+			res, err = client.Entities.TaggingDeleteTagFromEntityWithContext(ctx, guid, removedKeys)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if res != nil && len(res.Errors) > 0 {
+				return handleEntityTagsMutationEmbeddedErrors(res)
+			}
+			res = nil
+		}
+
+		if len(add) > 0 {
+			res, err = client.Entities.TaggingAddTagsToEntityWithContext(ctx, guid, add)
+		}
+	default:
+		res, err = client.Entities.TaggingReplaceTagsOnEntityWithContext(ctx, guid, tags)
+	}
+
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if res != nil && len(res.Errors) > 0 {
 		return handleEntityTagsMutationEmbeddedErrors(res)
 	}
+	d.SetId(string(guid))
 
-	retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		t, err := client.Entities.GetTagsForEntityMutable(common.EntityGUID(d.Id()))
+	retryErr := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		t, err := client.Entities.GetTagsForEntityWithContextMutable(ctx, guid)
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("error retrieving entity tags for guid %s: %s", d.Id(), err))
 		}
 
-		currentTags := convertTagTypes(t)
-
-		for _, t := range tags {
-			var tag *entities.TaggingTagInput
-			if tag = getTag(currentTags, t.Key); tag == nil {
-				return resource.RetryableError(fmt.Errorf("expected entity tag %s to have been updated but was not found", t.Key))
-			}
-
-			if ok := tagValuesExist(tag, t.Values); !ok {
-				return resource.RetryableError(fmt.Errorf("expected entity tag values %s to have been updated for tag %s but were not found", t.Values, t.Key))
-			}
+		if !entityTagsInSync(mode, convertTagTypes(t), tags, removedKeys) {
+			return resource.RetryableError(fmt.Errorf("expected entity tags for guid %s to have been applied but they were not yet", d.Id()))
 		}
 
 		diag := resourceNewRelicEntityTagsRead(ctx, d, meta)
 		if diag.HasError() {
-			return resource.RetryableError(errors.New("error reading tag values after creation"))
+			return resource.RetryableError(errors.New("error reading tag values after applying them"))
 		}
 
 		return nil
@@ -211,6 +242,11 @@ func resourceNewRelicEntityTagsDelete(ctx context.Context, d *schema.ResourceDat
 
 	log.Printf("[INFO] Deleting New Relic entity tags from entity guid %s", d.Id())
 
+	// Values added in additive mode may be shared with others, so they are left on the entity.
+	if d.Get("mode").(string) == entityTagsModeAdditive {
+		return nil
+	}
+
 	tags := expandEntityTags(d.Get("tag").(*schema.Set).List())
 	tagKeys := getTagKeys(tags)
 
@@ -284,16 +320,6 @@ func getTagKeys(tags []entities.TaggingTagInput) []string {
 	return tagKeys
 }
 
-func tagValuesExist(t *entities.TaggingTagInput, values []string) bool {
-	for _, v := range values {
-		if !stringInSlice(t.Values, v) {
-			return false
-		}
-	}
-
-	return true
-}
-
 func getTag(tags []*entities.TaggingTagInput, key string) *entities.TaggingTagInput {
 	for _, t := range tags {
 		log.Printf("[INFO] Checking tag %s compared to tag %s", t.Key, key)
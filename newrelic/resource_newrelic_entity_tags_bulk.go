@@ -0,0 +1,369 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+)
+
+func resourceNewRelicEntityTagsBulk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicEntityTagsBulkCreate,
+		ReadContext:   resourceNewRelicEntityTagsBulkRead,
+		UpdateContext: resourceNewRelicEntityTagsBulkUpdate,
+		DeleteContext: resourceNewRelicEntityTagsBulkDelete,
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The entity search query matching the entities to tag.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "A map of tag keys to the value each matching entity is tagged with.",
+			},
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				Description:  "The number of entities tagged per batch. Batches are tagged one after the other.",
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "The maximum number of entities of a batch tagged at the same time.",
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"entity_guids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The GUIDs of the matching entities which are tagged.",
+			},
+			"pending_entity_guids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The GUIDs of the matching entities which are not tagged yet, e.g. entities which started matching the query since the last apply.",
+			},
+			"departed_entity_guids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The GUIDs of the tagged entities which no longer match the query, and whose tags are yet to be deleted.",
+			},
+			"failed_entities": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "A map of entity GUIDs to the error returned by the last failed tagging of the entity.",
+			},
+		},
+		CustomizeDiff: validateEntityTagsBulk,
+	}
+}
+
+// Validates the tags and forces an update whenever a matching entity is not tagged, a
+// tagged entity no longer matches the query, or an entity failed to be tagged previously,
+// so that such entities are tagged or untagged on the next apply.
+func validateEntityTagsBulk(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for key := range d.Get("tags").(map[string]interface{}) {
+		if stringInSlice(defaultTags, key) {
+			return fmt.Errorf("tag key '%s' is a reserved key and cannot be managed", key)
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	pending := d.Get("pending_entity_guids").([]interface{})
+	departed := d.Get("departed_entity_guids").([]interface{})
+	failed := d.Get("failed_entities").(map[string]interface{})
+
+	if len(pending) == 0 && len(departed) == 0 && len(failed) == 0 && !d.HasChange("tags") {
+		return nil
+	}
+
+	for _, attr := range []string{"entity_guids", "pending_entity_guids", "departed_entity_guids", "failed_entities"} {
+		if err := d.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceNewRelicEntityTagsBulkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(id.UniqueId())
+
+	log.Printf("[INFO] Tagging New Relic entities matching %q", d.Get("query").(string))
+
+	return applyEntityTagsBulk(ctx, d, meta, nil)
+}
+
+func resourceNewRelicEntityTagsBulkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+	query := d.Get("query").(string)
+
+	log.Printf("[INFO] Reading New Relic entity tags of entities matching %q", query)
+
+	matched, err := getEntityTagsBulkEntities(ctx, client, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	declared := expandEntityTagsBulkTags(d.Get("tags").(map[string]interface{}))
+	tagged, pending := splitEntityTagsBulkEntities(matched, declared)
+	departed := departedEntityTagsBulkGUIDs(trackedEntityTagsBulkGUIDs(d), matched)
+
+	// The entity search lags behind tagging, so the tags of pending entities are checked
+	// directly. Entities whose tags cannot be retrieved are left pending.
+	var mu sync.Mutex
+	confirmed := map[string]bool{}
+	_ = runWithBoundedConcurrency(ctx, pending, d.Get("concurrency").(int), func(ctx context.Context, guid string) error {
+		t, err := client.Entities.GetTagsForEntityWithContextMutable(ctx, common.EntityGUID(guid))
+		if err != nil {
+			return err
+		}
+
+		if entityTagsInSync(entityTagsModeKeyAuthoritative, convertTagTypes(t), declared, nil) {
+			mu.Lock()
+			confirmed[guid] = true
+			mu.Unlock()
+		}
+
+		return nil
+	})
+
+	stillPending := []string{}
+	for _, guid := range pending {
+		if confirmed[guid] {
+			tagged = append(tagged, guid)
+		} else {
+			stillPending = append(stillPending, guid)
+		}
+	}
+	sort.Strings(tagged)
+
+	_ = d.Set("entity_guids", tagged)
+	_ = d.Set("pending_entity_guids", stillPending)
+	_ = d.Set("departed_entity_guids", departed)
+
+	return nil
+}
+
+func resourceNewRelicEntityTagsBulkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o, _ := d.GetChange("tags")
+	previous := expandEntityTagsBulkTags(o.(map[string]interface{}))
+
+	log.Printf("[INFO] Updating New Relic entity tags of entities matching %q", d.Get("query").(string))
+
+	return applyEntityTagsBulk(ctx, d, meta, previous)
+}
+
+func resourceNewRelicEntityTagsBulkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	guids := trackedEntityTagsBulkGUIDs(d)
+	keys := getTagKeys(expandEntityTagsBulkTags(d.Get("tags").(map[string]interface{})))
+
+	log.Printf("[INFO] Deleting New Relic entity tags from %d entities matching %q", len(guids), d.Get("query").(string))
+
+	failed := map[string]interface{}{}
+	for _, batch := range batchEntityTagsBulkGUIDs(guids, d.Get("batch_size").(int)) {
+		errs := runWithBoundedConcurrency(ctx, batch, d.Get("concurrency").(int), func(ctx context.Context, guid string) error {
+			return applyEntityTagsChanges(ctx, client, guid, keys, nil)
+		})
+
+		for guid, err := range errs {
+			failed[guid] = err.Error()
+		}
+	}
+
+	diags := entityTagsBulkFailureDiagnostics(failed)
+
+	// Entities whose tags could not be deleted are still tracked in state, so the
+	// resource is kept until the tags of every entity have been deleted.
+	if len(failed) > 0 {
+		remaining := []string{}
+		for _, guid := range guids {
+			if _, ok := failed[guid]; ok {
+				remaining = append(remaining, guid)
+			}
+		}
+
+		_ = d.Set("entity_guids", remaining)
+		_ = d.Set("departed_entity_guids", []string{})
+		_ = d.Set("failed_entities", failed)
+
+		return append(diags, diag.Errorf("the tags of %d entities could not be deleted", len(failed))...)
+	}
+
+	return diags
+}
+
+// Tags the matching entities whose tags differ from the declared ones, batch by batch,
+// with bounded concurrency within a batch. The declared keys are owned as in the
+// `key_authoritative` mode of newrelic_entity_tags: keys removed from `previous` are
+// deleted. The tagged entities which no longer match the query are untagged: the previous
+// and declared keys are deleted from their tags. Failures of individual entities are recorded in `failed_entities` and reported
+// as warnings rather than errors, so the rest of the entities are still tagged.
+func applyEntityTagsBulk(ctx context.Context, d *schema.ResourceData, meta interface{}, previous []entities.TaggingTagInput) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	matched, err := getEntityTagsBulkEntities(ctx, client, d.Get("query").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	declared := expandEntityTagsBulkTags(d.Get("tags").(map[string]interface{}))
+
+	type change struct {
+		deleteKeys []string
+		add        []entities.TaggingTagInput
+	}
+	changes := map[string]change{}
+	toTag := []string{}
+
+	for _, e := range matched {
+		deleteKeys, add := planEntityTagsKeyAuthoritative(convertTagTypes(e.Tags), declared, previous)
+		if len(deleteKeys) == 0 && len(add) == 0 {
+			continue
+		}
+
+		changes[e.GUID] = change{deleteKeys: deleteKeys, add: add}
+		toTag = append(toTag, e.GUID)
+	}
+
+	departed := departedEntityTagsBulkGUIDs(trackedEntityTagsBulkGUIDs(d), matched)
+	if len(departed) > 0 {
+		deleteKeys := getTagKeys(declared)
+		for _, key := range getTagKeys(previous) {
+			if !stringInSlice(deleteKeys, key) {
+				deleteKeys = append(deleteKeys, key)
+			}
+		}
+
+		for _, guid := range departed {
+			changes[guid] = change{deleteKeys: deleteKeys}
+			toTag = append(toTag, guid)
+		}
+	}
+
+	batches := batchEntityTagsBulkGUIDs(toTag, d.Get("batch_size").(int))
+	failed := map[string]interface{}{}
+
+	for i, batch := range batches {
+		log.Printf("[INFO] Tagging batch %d of %d of the %d entities to tag", i+1, len(batches), len(toTag))
+
+		errs := runWithBoundedConcurrency(ctx, batch, d.Get("concurrency").(int), func(ctx context.Context, guid string) error {
+			c := changes[guid]
+			return applyEntityTagsChanges(ctx, client, guid, c.deleteKeys, c.add)
+		})
+
+		for guid, err := range errs {
+			failed[guid] = err.Error()
+		}
+	}
+
+	// Departed entities which could not be untagged are still tracked, so they are
+	// untagged on the next apply.
+	stillTagged := []string{}
+	for _, guid := range expandStringSlice(d.Get("entity_guids").([]interface{})) {
+		if !stringInSlice(departed, guid) {
+			stillTagged = append(stillTagged, guid)
+		}
+	}
+	stillDeparted := []string{}
+	for _, guid := range departed {
+		if _, ok := failed[guid]; ok {
+			stillDeparted = append(stillDeparted, guid)
+		}
+	}
+
+	_ = d.Set("entity_guids", stillTagged)
+	_ = d.Set("departed_entity_guids", stillDeparted)
+	_ = d.Set("failed_entities", failed)
+
+	return append(entityTagsBulkFailureDiagnostics(failed), resourceNewRelicEntityTagsBulkRead(ctx, d, meta)...)
+}
+
+// Deletes the keys from the tags of the entity, then adds the tags.
+func applyEntityTagsChanges(ctx context.Context, client *newrelic.NewRelic, guid string, deleteKeys []string, add []entities.TaggingTagInput) error {
+	if len(deleteKeys) > 0 {
+		res, err := client.Entities.TaggingDeleteTagFromEntityWithContext(ctx, common.EntityGUID(guid), deleteKeys)
+		if err != nil {
+			return err
+		}
+		if err := entityTagsMutationError(res); err != nil {
+			return err
+		}
+	}
+
+	if len(add) > 0 {
+		res, err := client.Entities.TaggingAddTagsToEntityWithContext(ctx, common.EntityGUID(guid), add)
+		if err != nil {
+			return err
+		}
+		if err := entityTagsMutationError(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func entityTagsMutationError(res *entities.TaggingMutationResult) error {
+	if res == nil || len(res.Errors) == 0 {
+		return nil
+	}
+
+	messages := []string{}
+	for _, diag := range handleEntityTagsMutationEmbeddedErrors(res) {
+		messages = append(messages, diag.Summary)
+	}
+
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
+
+func entityTagsBulkFailureDiagnostics(failed map[string]interface{}) diag.Diagnostics {
+	guids := make([]string, 0, len(failed))
+	for guid := range failed {
+		guids = append(guids, guid)
+	}
+	sort.Strings(guids)
+
+	var diags diag.Diagnostics
+	for _, guid := range guids {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("entity %q: %s", guid, failed[guid]),
+		})
+	}
+
+	return diags
+}
+
+// Returns the GUIDs of the entities tagged by the resource: the tagged entities, and the
+// ones which no longer match the query but are yet to be untagged.
+func trackedEntityTagsBulkGUIDs(d *schema.ResourceData) []string {
+	guids := expandStringSlice(d.Get("entity_guids").([]interface{}))
+	return append(guids, expandStringSlice(d.Get("departed_entity_guids").([]interface{}))...)
+}
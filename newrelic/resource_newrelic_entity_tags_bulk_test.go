@@ -0,0 +1,84 @@
+//go:build integration || ENTITY
+// +build integration ENTITY
+
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+)
+
+func TestAccNewRelicEntityTagsBulk_Basic(t *testing.T) {
+	resourceName := "newrelic_entity_tags_bulk.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicEntityTagsBulkDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicEntityTagsBulkConfig(testAccExpectedApplicationName, "test_value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entity_guids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_entity_guids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "departed_entity_guids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "failed_entities.%", "0"),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccNewRelicEntityTagsBulkConfig(testAccExpectedApplicationName, "test_value_2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.test_bulk_key", "test_value_2"),
+					resource.TestCheckResourceAttr(resourceName, "entity_guids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_entity_guids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicEntityTagsBulkConfig(appName string, tagValue string) string {
+	return fmt.Sprintf(`
+resource "newrelic_entity_tags_bulk" "foo" {
+  query = "name = '%s' AND domain = 'APM' AND type = 'APPLICATION'"
+
+  tags = {
+    test_bulk_key = "%s"
+  }
+}
+`, appName, tagValue)
+}
+
+func testAccCheckNewRelicEntityTagsBulkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).NewClient
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_entity_tags_bulk" {
+			continue
+		}
+
+		for key, guid := range r.Primary.Attributes {
+			if !strings.HasPrefix(key, "entity_guids.") || key == "entity_guids.#" {
+				continue
+			}
+
+			t, err := client.Entities.GetTagsForEntityWithContextMutable(context.Background(), common.EntityGUID(guid))
+			if err != nil {
+				return err
+			}
+
+			if tag := getTag(convertTagTypes(t), "test_bulk_key"); tag != nil {
+				return fmt.Errorf("entity tag test_bulk_key still exists for GUID %s", guid)
+			}
+		}
+	}
+
+	return nil
+}
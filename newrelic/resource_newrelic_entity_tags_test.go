@@ -15,10 +15,11 @@ import (
 	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
 )
 
+// The entity tags tests tag the same application, so they don't run in parallel.
 func TestAccNewRelicEntityTags_Basic(t *testing.T) {
 	resourceName := "newrelic_entity_tags.foo"
 
-	resource.ParallelTest(t, resource.TestCase{
+	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckNewRelicEntityTagsDestroy,
@@ -66,6 +67,36 @@ func TestAccNewRelicEntityTags_Basic(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicEntityTags_Modes(t *testing.T) {
+	resourceName := "newrelic_entity_tags.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicEntityTagsDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicEntityTagsModeConfig(testAccExpectedApplicationName, "additive", "test_mode_key", "test_value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "additive"),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+					testAccCheckNewRelicEntityTagsExist(resourceName, []string{"test_mode_key"}),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccNewRelicEntityTagsModeConfig(testAccExpectedApplicationName, "key_authoritative", "test_mode_key", "test_value_2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "key_authoritative"),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tag.*.values.*", "test_value_2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNewRelicEntityTagsDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ProviderConfig).NewClient
 	for _, r := range s.RootModule().Resources {
@@ -176,3 +207,23 @@ resource "newrelic_entity_tags" "foo" {
 }
 `, appName, tagKey, tagValue)
 }
+
+func testAccNewRelicEntityTagsModeConfig(appName string, mode string, tagKey string, tagValue string) string {
+	return fmt.Sprintf(`
+data "newrelic_entity" "foo" {
+  name = "%s"
+  type = "APPLICATION"
+  domain = "APM"
+}
+
+resource "newrelic_entity_tags" "foo" {
+  guid = data.newrelic_entity.foo.guid
+  mode = "%s"
+
+  tag {
+	key = "%s"
+	values = ["%s"]
+  }
+}
+`, appName, mode, tagKey, tagValue)
+}
@@ -24,6 +24,7 @@ func resourceNewRelicServiceLevel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeDiffServiceLevel,
 		Schema: map[string]*schema.Schema{
 			"guid": {
 				Type:         schema.TypeString,
@@ -176,9 +177,10 @@ func objectiveSchema() *schema.Resource {
 				Description: "",
 			},
 			"target": {
-				Type:        schema.TypeFloat,
-				Required:    true,
-				Description: "",
+				Type:         schema.TypeFloat,
+				Required:     true,
+				Description:  "",
+				ValidateFunc: validateServiceLevelObjectiveTarget,
 			},
 			"time_window": {
 				Type:        schema.TypeList,
@@ -226,6 +228,17 @@ func rollingTimeWindowSchema() *schema.Resource {
 	}
 }
 
+// MaxItems rejects more than one objective when validating the configuration, but not
+// objectives from dynamic blocks which are only known when planning. Objectives which
+// conflict on the same time window are reported then.
+func customizeDiffServiceLevel(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("objective") {
+		return nil
+	}
+
+	return validateServiceLevelObjectives(d.Get("objective").(*schema.Set).List())
+}
+
 func resourceNewRelicServiceLevelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).NewClient
 	entityGUID := d.Get("guid").(string)
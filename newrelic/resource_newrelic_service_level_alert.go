@@ -0,0 +1,413 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/servicelevel"
+)
+
+func resourceNewRelicServiceLevelAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicServiceLevelAlertCreate,
+		ReadContext:   resourceNewRelicServiceLevelAlertRead,
+		UpdateContext: resourceNewRelicServiceLevelAlertUpdate,
+		DeleteContext: resourceNewRelicServiceLevelAlertDelete,
+		CustomizeDiff: customizeDiffServiceLevelAlert,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The New Relic account ID of the alert policy.",
+			},
+			"policy_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the alert policy the conditions are created in.",
+			},
+			"sli_guid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The GUID of the service level indicator to alert on.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The prefix of the names of the conditions.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the conditions are enabled.",
+			},
+			"runbook_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The runbook URL of the conditions.",
+			},
+			"slo_target": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+				Description:  "The target of the service level objective. Defaults to the target of the service level, and follows it.",
+			},
+			"slo_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 7, 28}),
+				Description:  "The period of the service level objective, in days. Defaults to the period of the service level, and follows it.",
+			},
+			"window": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The burn rate windows to alert on. Defaults to a critical 1 hour window and a warning 6 hours window.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"severity": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"critical", "warning"}, false),
+							Description:  "The priority of the condition of the window, critical or warning.",
+						},
+						"aggregation_window": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(60, 21600),
+							Description:  "The window the burn rate is aggregated over, in seconds.",
+						},
+						"slide_by": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(30, 21600),
+							Description:  "The interval, in seconds, which the aggregation window slides by. Usually a twelfth of the aggregation window.",
+						},
+						"tolerated_budget_consumption": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+							Description:  "The percentage of the error budget which can be consumed within the aggregation window.",
+						},
+					},
+				},
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The NRQL conditions created for the windows, one per window.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"window": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"condition_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Keeps the thresholds in sync with the service level: when `slo_target` or `slo_period`
+// are not configured, they follow the objective of the service level, and any change of
+// the objective, or of the conditions outside of Terraform, plans an update.
+func customizeDiffServiceLevelAlert(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	windows := expandServiceLevelAlertWindows(d.Get("window").([]interface{}))
+	if err := validateServiceLevelAlertWindows(windows); err != nil {
+		return err
+	}
+
+	rawConfig := d.GetRawConfig()
+	targetConfigured := !rawConfig.GetAttr("slo_target").IsNull()
+	periodConfigured := !rawConfig.GetAttr("slo_period").IsNull()
+
+	if !targetConfigured || !periodConfigured {
+		if !d.NewValueKnown("sli_guid") {
+			for key, configured := range map[string]bool{"slo_target": targetConfigured, "slo_period": periodConfigured} {
+				if !configured {
+					if err := d.SetNewComputed(key); err != nil {
+						return err
+					}
+				}
+			}
+			return d.SetNewComputed("conditions")
+		}
+
+		indicator, err := getServiceLevelAlertIndicator(ctx, meta.(*ProviderConfig).NewClient, d.Get("sli_guid").(string))
+		if err != nil {
+			return err
+		}
+
+		sloTarget, sloPeriod, err := serviceLevelAlertObjective(indicator)
+		if err != nil {
+			return err
+		}
+
+		if !targetConfigured && d.Get("slo_target").(float64) != sloTarget {
+			if err := d.SetNew("slo_target", sloTarget); err != nil {
+				return err
+			}
+		}
+
+		if !periodConfigured && d.Get("slo_period").(int) != sloPeriod {
+			if err := d.SetNew("slo_period", sloPeriod); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("slo_target") || !d.NewValueKnown("slo_period") || d.HasChanges("name", "enabled", "runbook_url") {
+		return d.SetNewComputed("conditions")
+	}
+
+	desired := serviceLevelAlertConditions(windows, d.Get("slo_target").(float64), d.Get("slo_period").(int))
+	current := expandServiceLevelAlertConditions(d.Get("conditions").([]interface{}))
+
+	if !serviceLevelAlertConditionsInSync(current, desired) {
+		return d.SetNewComputed("conditions")
+	}
+
+	return nil
+}
+
+func resourceNewRelicServiceLevelAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+	policyID := d.Get("policy_id").(int)
+	sliGUID := d.Get("sli_guid").(string)
+
+	log.Printf("[INFO] Creating New Relic service level alert for %s in policy %d", sliGUID, policyID)
+
+	d.SetId(fmt.Sprintf("%d:%s", policyID, sliGUID))
+	_ = d.Set("account_id", accountID)
+
+	return applyServiceLevelAlert(ctx, d, providerConfig.NewClient, accountID)
+}
+
+func resourceNewRelicServiceLevelAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading New Relic service level alert %s", d.Id())
+
+	_, err := client.Alerts.QueryPolicyWithContext(ctx, accountID, strconv.Itoa(d.Get("policy_id").(int)))
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Conditions deleted or changed outside of Terraform are left out of state, or kept
+	// with their actual threshold, so that the next plan restores them.
+	conditions := []serviceLevelAlertCondition{}
+	for _, c := range expandServiceLevelAlertConditions(d.Get("conditions").([]interface{})) {
+		condition, err := client.Alerts.GetNrqlConditionQueryWithContext(ctx, accountID, c.conditionID)
+		if err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+
+		if len(condition.Terms) > 0 && condition.Terms[0].Threshold != nil {
+			c.threshold = *condition.Terms[0].Threshold
+		}
+
+		if condition.Signal != nil && condition.Signal.AggregationWindow != nil {
+			c.window = *condition.Signal.AggregationWindow
+		}
+
+		conditions = append(conditions, c)
+	}
+
+	_ = d.Set("account_id", accountID)
+
+	return diag.FromErr(d.Set("conditions", flattenServiceLevelAlertConditions(conditions)))
+}
+
+func resourceNewRelicServiceLevelAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Updating New Relic service level alert %s", d.Id())
+
+	return applyServiceLevelAlert(ctx, d, providerConfig.NewClient, accountID)
+}
+
+func resourceNewRelicServiceLevelAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Deleting New Relic service level alert %s", d.Id())
+
+	for _, c := range expandServiceLevelAlertConditions(d.Get("conditions").([]interface{})) {
+		if _, err := client.Alerts.DeleteNrqlConditionMutationWithContext(ctx, accountID, c.conditionID); err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// Creates, updates and deletes the conditions of the alert to match the configuration
+// and the objective of the service level. State is saved after every change, so that
+// the conditions created before a failure are not orphaned.
+func applyServiceLevelAlert(ctx context.Context, d *schema.ResourceData, client *newrelic.NewRelic, accountID int) diag.Diagnostics {
+	sliGUID := d.Get("sli_guid").(string)
+
+	indicator, err := getServiceLevelAlertIndicator(ctx, client, sliGUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sloTarget, sloPeriod, err := serviceLevelAlertObjective(indicator)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.GetAttr("slo_target").IsNull() {
+		sloTarget = d.Get("slo_target").(float64)
+	}
+
+	if !rawConfig.GetAttr("slo_period").IsNull() {
+		sloPeriod = d.Get("slo_period").(int)
+	}
+
+	_ = d.Set("slo_target", sloTarget)
+	_ = d.Set("slo_period", sloPeriod)
+
+	windows := expandServiceLevelAlertWindows(d.Get("window").([]interface{}))
+	if err := validateServiceLevelAlertWindows(windows); err != nil {
+		return diag.FromErr(err)
+	}
+
+	nrql := serviceLevelAlertNrql(sliGUID, indicator.Events.BadEvents != nil)
+	name := d.Get("name").(string)
+	enabled := d.Get("enabled").(bool)
+	runbookURL := d.Get("runbook_url").(string)
+	policyID := strconv.Itoa(d.Get("policy_id").(int))
+
+	existing := map[string]serviceLevelAlertCondition{}
+	for _, c := range expandServiceLevelAlertConditions(d.Get("conditions").([]interface{})) {
+		existing[c.key()] = c
+	}
+
+	applied := []serviceLevelAlertCondition{}
+	save := func(pending map[string]serviceLevelAlertCondition) error {
+		conditions := append([]serviceLevelAlertCondition{}, applied...)
+		for _, c := range pending {
+			conditions = append(conditions, c)
+		}
+		return d.Set("conditions", flattenServiceLevelAlertConditions(conditions))
+	}
+
+	for _, c := range serviceLevelAlertConditions(windows, sloTarget, sloPeriod) {
+		input := expandServiceLevelAlertConditionInput(c, name, nrql, enabled, runbookURL)
+
+		if current, ok := existing[c.key()]; ok {
+			delete(existing, c.key())
+			c.conditionID = current.conditionID
+
+			_, err := client.Alerts.UpdateNrqlConditionStaticMutationWithContext(ctx, accountID, c.conditionID, expandServiceLevelAlertConditionUpdateInput(input))
+			if err != nil {
+				if _, ok := err.(*errors.NotFound); !ok {
+					_ = save(existing)
+					return diag.FromErr(err)
+				}
+				c.conditionID = ""
+			}
+		}
+
+		if c.conditionID == "" {
+			created, err := client.Alerts.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, policyID, input)
+			if err != nil {
+				_ = save(existing)
+				return diag.FromErr(err)
+			}
+			c.conditionID = created.ID
+		}
+
+		applied = append(applied, c)
+	}
+
+	for key, c := range existing {
+		if _, err := client.Alerts.DeleteNrqlConditionMutationWithContext(ctx, accountID, c.conditionID); err != nil {
+			if _, ok := err.(*errors.NotFound); !ok {
+				_ = save(existing)
+				return diag.FromErr(err)
+			}
+		}
+		delete(existing, key)
+	}
+
+	return diag.FromErr(save(existing))
+}
+
+func getServiceLevelAlertIndicator(ctx context.Context, client *newrelic.NewRelic, sliGUID string) (*servicelevel.ServiceLevelIndicator, error) {
+	indicators, err := client.ServiceLevel.GetIndicatorsWithContext(ctx, common.EntityGUID(sliGUID))
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return nil, fmt.Errorf("service level %s not found", sliGUID)
+		}
+		return nil, err
+	}
+
+	for _, indicator := range *indicators {
+		if string(indicator.GUID) == sliGUID {
+			return &indicator, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no service level indicator with GUID %s found", sliGUID)
+}
+
+// Returns the target and the period, in days, of the objective of a service level.
+func serviceLevelAlertObjective(indicator *servicelevel.ServiceLevelIndicator) (float64, int, error) {
+	if len(indicator.Objectives) == 0 {
+		return 0, 0, fmt.Errorf("service level %s has no objective", indicator.GUID)
+	}
+
+	objective := indicator.Objectives[0]
+
+	return objective.Target, objective.TimeWindow.Rolling.Count, nil
+}
@@ -0,0 +1,145 @@
+//go:build integration || WORKLOADS
+// +build integration WORKLOADS
+
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+)
+
+func TestAccNewRelicServiceLevelAlert_Basic(t *testing.T) {
+	resourceName := "newrelic_service_level_alert.alert"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicServiceLevelAlertDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create with the default windows and the target of the service level
+			{
+				Config: testAccNewRelicServiceLevelAlertConfig(rName, 99.00, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "slo_target", "99"),
+					resource.TestCheckResourceAttr(resourceName, "slo_period", "7"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.severity", "critical"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.window", "3600"),
+				),
+			},
+			// Test: The thresholds follow a change of the target of the service level
+			{
+				Config: testAccNewRelicServiceLevelAlertConfig(rName, 99.50, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "slo_target", "99.5"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.threshold", fmt.Sprintf("%g", calculateThreshold(99.5, 2, 7, 3600))),
+				),
+			},
+			// Test: Update the windows
+			{
+				Config: testAccNewRelicServiceLevelAlertConfig(rName, 99.50, `
+	window {
+		severity                     = "critical"
+		aggregation_window           = 3600
+		slide_by                     = 300
+		tolerated_budget_consumption = 2
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicServiceLevelAlertConfig(name string, target float64, windows string) string {
+	return fmt.Sprintf(`
+resource "newrelic_workload" "workload" {
+	name = "%[2]s"
+	account_id = %[1]d
+	entity_search_query {
+		query = "tags.namespace like '%%App%%' "
+	}
+	scope_account_ids =  [%[1]d]
+}
+
+resource "newrelic_service_level" "sli" {
+	guid = newrelic_workload.workload.guid
+	name = "%[2]s"
+
+	events {
+		account_id = %[1]d
+		valid_events {
+			from = "Transaction"
+		}
+		bad_events {
+			from = "TransactionError"
+		}
+	}
+
+	objective {
+		target = %[3]f
+		time_window {
+			rolling {
+				count = 7
+				unit = "DAY"
+			}
+		}
+	}
+}
+
+resource "newrelic_alert_policy" "policy" {
+	account_id = %[1]d
+	name       = "%[2]s"
+}
+
+resource "newrelic_service_level_alert" "alert" {
+	account_id = %[1]d
+	policy_id  = newrelic_alert_policy.policy.id
+	sli_guid   = newrelic_service_level.sli.sli_guid
+	name       = "%[2]s"
+%[4]s
+	depends_on = [newrelic_service_level.sli]
+}
+`, testAccountID, name, target, windows)
+}
+
+func testAccCheckNewRelicServiceLevelAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_service_level_alert" {
+			continue
+		}
+
+		for _, c := range testAccServiceLevelAlertConditionIDs(r.Primary.Attributes) {
+			_, err := client.Alerts.GetNrqlConditionQueryWithContext(context.Background(), testAccountID, c)
+			if err == nil {
+				return fmt.Errorf("NRQL condition %s of service level alert %s still exists", c, r.Primary.ID)
+			}
+			if _, ok := err.(*errors.NotFound); !ok {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceLevelAlertConditionIDs(attributes map[string]string) []string {
+	ids := []string{}
+	for i := 0; ; i++ {
+		id, ok := attributes[fmt.Sprintf("conditions.%d.condition_id", i)]
+		if !ok {
+			return ids
+		}
+		ids = append(ids, id)
+	}
+}
@@ -0,0 +1,350 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/servicelevel"
+)
+
+func resourceNewRelicServiceLevelComposite() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicServiceLevelCompositeCreate,
+		ReadContext:   resourceNewRelicServiceLevelCompositeRead,
+		UpdateContext: resourceNewRelicServiceLevelCompositeUpdate,
+		DeleteContext: resourceNewRelicServiceLevelCompositeDelete,
+		CustomizeDiff: customizeDiffServiceLevelComposite,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The GUID of the entity the composite service level is attached to, usually a workload.",
+			},
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the account the metrics of the combined service levels are queried from.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The name of the composite service level.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the composite service level.",
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{serviceLevelCompositeProduct, serviceLevelCompositeWeightedAverage}, false),
+				Description:  "How the attainments of the service levels are combined: `product` for service levels which must all succeed, `weighted_average` for service levels of alternative paths.",
+			},
+			"component": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    2,
+				Description: "A service level combined by the composite service level.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sli_guid": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.All(validation.StringIsNotWhiteSpace, validation.StringDoesNotContainAny("'")),
+							Description:  "The GUID of the service level indicator.",
+						},
+						"weight": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "The weight of the service level, with the `weighted_average` method.",
+						},
+					},
+				},
+			},
+			"target": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateServiceLevelObjectiveTarget,
+				Description:  "The target of the objective of the composite service level. Defaults to the targets of the objectives of the components, combined by the method.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 7, 28}),
+				Description:  "The rolling period of the objective, in days.",
+			},
+			"nrql": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The query of the attainment of the composite service level, combined by the method.",
+			},
+			"sli_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the composite service level indicator.",
+			},
+			"sli_guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The GUID of the composite service level indicator.",
+			},
+		},
+	}
+}
+
+// Sets the target, when not configured, and the query from the objectives of the components.
+func customizeDiffServiceLevelComposite(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	targetConfigured := !d.GetRawConfig().GetAttr("target").IsNull()
+
+	if !d.NewValueKnown("component") {
+		if !targetConfigured {
+			if err := d.SetNewComputed("target"); err != nil {
+				return err
+			}
+		}
+		return d.SetNewComputed("nrql")
+	}
+
+	components, err := getServiceLevelCompositeComponents(ctx, meta.(*ProviderConfig).NewClient, d.Get("component").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if _, err := expandServiceLevelCompositeEvents(0, components); err != nil {
+		return err
+	}
+
+	method := d.Get("method").(string)
+	if err := validateServiceLevelCompositeWeights(method, components); err != nil {
+		return err
+	}
+
+	if target := combineServiceLevelCompositeTargets(method, components); !targetConfigured && d.Get("target").(float64) != target {
+		if err := d.SetNew("target", target); err != nil {
+			return err
+		}
+	}
+
+	if nrql := serviceLevelCompositeNrql(method, components); d.Get("nrql").(string) != nrql {
+		return d.SetNew("nrql", nrql)
+	}
+
+	return nil
+}
+
+// Returns the components of the configuration, with the objective and the kind of events
+// of their service level indicators.
+func getServiceLevelCompositeComponents(ctx context.Context, client *newrelic.NewRelic, cfg []interface{}) ([]serviceLevelCompositeComponent, error) {
+	components := expandServiceLevelCompositeComponents(cfg)
+
+	for i, c := range components {
+		indicator, err := getServiceLevelAlertIndicator(ctx, client, c.sliGUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if components[i].target, _, err = serviceLevelAlertObjective(indicator); err != nil {
+			return nil, err
+		}
+		components[i].isBadEvents = indicator.Events.BadEvents != nil
+	}
+
+	return components, nil
+}
+
+func expandServiceLevelCompositeObjective(d *schema.ResourceData) servicelevel.ServiceLevelObjectiveCreateInput {
+	return servicelevel.ServiceLevelObjectiveCreateInput{
+		Target: d.Get("target").(float64),
+		TimeWindow: servicelevel.ServiceLevelObjectiveTimeWindowCreateInput{
+			Rolling: servicelevel.ServiceLevelObjectiveRollingTimeWindowCreateInput{
+				Count: d.Get("period").(int),
+				Unit:  servicelevel.ServiceLevelObjectiveRollingTimeWindowUnitTypes.DAY,
+			},
+		},
+	}
+}
+
+func resourceNewRelicServiceLevelCompositeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	entityGUID := d.Get("guid").(string)
+
+	components, err := getServiceLevelCompositeComponents(ctx, client, d.Get("component").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	events, err := expandServiceLevelCompositeEvents(accountID, components)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createInput := servicelevel.ServiceLevelIndicatorCreateInput{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Events:      events,
+		Objectives:  []servicelevel.ServiceLevelObjectiveCreateInput{expandServiceLevelCompositeObjective(d)},
+	}
+
+	log.Printf("[INFO] Creating New Relic composite service level %s", createInput.Name)
+
+	created, err := client.ServiceLevel.ServiceLevelCreateWithContext(ctx, common.EntityGUID(entityGUID), createInput)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	identifier := serviceLevelIdentifier{
+		AccountID:  accountID,
+		ID:         created.ID,
+		EntityGUID: entityGUID,
+	}
+
+	d.SetId(identifier.String())
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("sli_id", created.ID)
+	_ = d.Set("sli_guid", getSliGUID(&identifier))
+	return resourceNewRelicServiceLevelCompositeRead(ctx, d, meta)
+}
+
+func resourceNewRelicServiceLevelCompositeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	identifier, err := parseIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sliGUID := getSliGUID(identifier)
+	indicators, err := client.ServiceLevel.GetIndicatorsWithContext(ctx, common.EntityGUID(sliGUID))
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	for _, indicator := range *indicators {
+		if indicator.ID != identifier.ID {
+			continue
+		}
+
+		_ = d.Set("guid", identifier.EntityGUID)
+		_ = d.Set("account_id", identifier.AccountID)
+		_ = d.Set("sli_id", indicator.ID)
+		_ = d.Set("sli_guid", sliGUID)
+		_ = d.Set("name", indicator.Name)
+		_ = d.Set("description", indicator.Description)
+
+		// The components are read back from the events, so that changes of the events
+		// outside of Terraform show up in the plan. The method and the weights are only
+		// kept in state, as the events pool the events of the components.
+		weights := map[string]float64{}
+		for _, c := range expandServiceLevelCompositeComponents(d.Get("component").([]interface{})) {
+			weights[c.sliGUID] = c.weight
+		}
+
+		components := flattenServiceLevelCompositeComponents(indicator.Events, weights)
+		if err := d.Set("component", components); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if method := d.Get("method").(string); components != nil && method != "" {
+			expanded := expandServiceLevelCompositeComponents(components)
+			for i := range expanded {
+				expanded[i].isBadEvents = indicator.Events.BadEvents != nil
+			}
+			_ = d.Set("nrql", serviceLevelCompositeNrql(method, expanded))
+		}
+
+		if len(indicator.Objectives) > 0 {
+			_ = d.Set("target", indicator.Objectives[0].Target)
+			_ = d.Set("period", indicator.Objectives[0].TimeWindow.Rolling.Count)
+		}
+
+		return nil
+	}
+
+	return diag.Errorf("err: SLI with id=%s not found.", d.Id())
+}
+
+func resourceNewRelicServiceLevelCompositeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	identifier, err := parseIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	components, err := getServiceLevelCompositeComponents(ctx, client, d.Get("component").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	events, err := expandServiceLevelCompositeEvents(identifier.AccountID, components)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	objective := expandServiceLevelCompositeObjective(d)
+	updateInput := servicelevel.ServiceLevelIndicatorUpdateInput{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Events:      expandServiceLevelCompositeEventsUpdateInput(events),
+		Objectives: []servicelevel.ServiceLevelObjectiveUpdateInput{
+			{
+				Target: objective.Target,
+				TimeWindow: servicelevel.ServiceLevelObjectiveTimeWindowUpdateInput{
+					Rolling: servicelevel.ServiceLevelObjectiveRollingTimeWindowUpdateInput{
+						Count: objective.TimeWindow.Rolling.Count,
+						Unit:  objective.TimeWindow.Rolling.Unit,
+					},
+				},
+			},
+		},
+	}
+
+	log.Printf("[INFO] Updating New Relic composite service level %s", d.Id())
+
+	if _, err := client.ServiceLevel.ServiceLevelUpdateWithContext(ctx, common.EntityGUID(getSliGUID(identifier)), updateInput); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNewRelicServiceLevelCompositeRead(ctx, d, meta)
+}
+
+func resourceNewRelicServiceLevelCompositeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Deleting New Relic composite service level %s", d.Id())
+
+	identifier, err := parseIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.ServiceLevel.ServiceLevelDeleteWithContext(ctx, common.EntityGUID(getSliGUID(identifier))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
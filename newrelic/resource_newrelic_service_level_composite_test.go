@@ -0,0 +1,141 @@
+//go:build integration || WORKLOADS
+// +build integration WORKLOADS
+
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+)
+
+func TestAccNewRelicServiceLevelComposite_Basic(t *testing.T) {
+	resourceName := "newrelic_service_level_composite.journey"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicServiceLevelCompositeDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create with the product of the targets of the service levels
+			{
+				Config: testAccNewRelicServiceLevelCompositeConfig(rName, `method = "product"`, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target", "98.505"),
+					resource.TestCheckResourceAttr(resourceName, "period", "7"),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "sli_guid"),
+					resource.TestCheckResourceAttrSet(resourceName, "nrql"),
+				),
+			},
+			// Test: Update to the weighted average of the targets
+			{
+				Config: testAccNewRelicServiceLevelCompositeConfig(rName, `method = "weighted_average"`, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target", "99.125"),
+					resource.TestCheckResourceAttr(resourceName, "component.0.weight", "3"),
+				),
+			},
+			// Test: Update to a configured target
+			{
+				Config: testAccNewRelicServiceLevelCompositeConfig(rName, "method = \"weighted_average\"\n\ttarget = 99.1", 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target", "99.1"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The method and the weights are not part of the events of the service level.
+				ImportStateVerifyIgnore: []string{"method", "nrql", "component.0.weight"},
+			},
+		},
+	})
+}
+
+func testAccNewRelicServiceLevelCompositeConfig(name string, attributes string, checkoutWeight float64) string {
+	sli := func(resourceName string, target float64) string {
+		return fmt.Sprintf(`
+resource "newrelic_service_level" "%[3]s" {
+	guid = newrelic_workload.workload.guid
+	name = "%[2]s %[3]s"
+
+	events {
+		account_id = %[1]d
+		valid_events {
+			from = "Transaction"
+		}
+		bad_events {
+			from = "TransactionError"
+		}
+	}
+
+	objective {
+		target = %[4]f
+		time_window {
+			rolling {
+				count = 7
+				unit = "DAY"
+			}
+		}
+	}
+}
+`, testAccountID, name, resourceName, target)
+	}
+
+	return fmt.Sprintf(`
+resource "newrelic_workload" "workload" {
+	name = "%[2]s"
+	account_id = %[1]d
+	entity_search_query {
+		query = "tags.namespace like '%%App%%' "
+	}
+	scope_account_ids =  [%[1]d]
+}
+%[4]s
+%[5]s
+resource "newrelic_service_level_composite" "journey" {
+	guid   = newrelic_workload.workload.guid
+	name   = "%[2]s journey"
+	period = 7
+	%[3]s
+
+	component {
+		sli_guid = newrelic_service_level.checkout.sli_guid
+		weight   = %[6]f
+	}
+
+	component {
+		sli_guid = newrelic_service_level.payment.sli_guid
+	}
+}
+`, testAccountID, name, attributes, sli("checkout", 99), sli("payment", 99.5), checkoutWeight)
+}
+
+func testAccCheckNewRelicServiceLevelCompositeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_service_level_composite" {
+			continue
+		}
+
+		_, err := client.ServiceLevel.GetIndicatorsWithContext(context.Background(), common.EntityGUID(r.Primary.Attributes["sli_guid"]))
+		if err == nil {
+			return fmt.Errorf("composite service level %s still exists", r.Primary.ID)
+		}
+		if _, ok := err.(*errors.NotFound); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
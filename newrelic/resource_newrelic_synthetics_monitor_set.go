@@ -0,0 +1,457 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/synthetics"
+)
+
+// The monitors of a set, created from a map of monitor names to URIs and the shared template.
+var syntheticsMonitorSet = keyedSet{
+	items:       "monitors",
+	ids:         "monitor_guids",
+	failed:      "failed_monitors",
+	shared:      []string{"template"},
+	computed:    []string{"monitor_ids"},
+	description: "synthetics monitor",
+}
+
+func resourceNewRelicSyntheticsMonitorSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicSyntheticsMonitorSetCreate,
+		ReadContext:   resourceNewRelicSyntheticsMonitorSetRead,
+		UpdateContext: resourceNewRelicSyntheticsMonitorSetUpdate,
+		DeleteContext: resourceNewRelicSyntheticsMonitorSetDelete,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Description: "ID of the newrelic account.",
+				ForceNew:    true,
+				Computed:    true,
+				Optional:    true,
+			},
+			"monitors": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "A map of monitor names to the URI each monitor runs against.",
+			},
+			"template": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The configuration shared by every monitor in the set.",
+				Elem: &schema.Resource{
+					Schema: syntheticsMonitorSetTemplateSchema(),
+				},
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "The maximum number of monitors created, updated or deleted at the same time.",
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"monitor_guids": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "A map of monitor names to the GUIDs of the monitors created.",
+			},
+			"monitor_ids": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "A map of monitor names to the IDs of the monitors created.",
+			},
+			"failed_monitors": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "A map of monitor names to the error returned by the last failed create, update or delete of the monitor.",
+			},
+		},
+		CustomizeDiff: validateSyntheticsMonitorSet,
+	}
+}
+
+func syntheticsMonitorSetTemplateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      string(SyntheticsMonitorTypes.SIMPLE),
+			ForceNew:     true,
+			Description:  "The monitor type. Valid values are SIMPLE AND BROWSER.",
+			ValidateFunc: validation.StringInSlice([]string{string(SyntheticsMonitorTypes.SIMPLE), string(SyntheticsMonitorTypes.BROWSER)}, false),
+		},
+		"period": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "The interval at which the monitors should run. Valid values are EVERY_MINUTE, EVERY_5_MINUTES, EVERY_10_MINUTES, EVERY_15_MINUTES, EVERY_30_MINUTES, EVERY_HOUR, EVERY_6_HOURS, EVERY_12_HOURS, or EVERY_DAY.",
+			ValidateFunc: validation.StringInSlice(listValidSyntheticsMonitorPeriods(), false),
+		},
+		"status": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "The monitor status (ENABLED or DISABLED).",
+			ValidateFunc: validateSyntheticMonitorStatus,
+		},
+		"locations_public": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Publicly available location names in which the monitors will run.",
+		},
+		"locations_private": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "List private location GUIDs for which the monitors will run.",
+		},
+		"tag": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "The tags that will be associated with the monitors.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the tag key",
+					},
+					"values": {
+						Type:        schema.TypeList,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Required:    true,
+						Description: "Values associated with the tag key",
+					},
+				},
+			},
+		},
+		"custom_header": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Custom headers to use in monitor jobs.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Header name",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Header value",
+					},
+				},
+			},
+		},
+		"validation_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The string to validate against in the response.",
+		},
+		"verify_ssl": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Verify SSL.",
+		},
+		"bypass_head_request": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Bypass HEAD request. Only applies to SIMPLE monitors.",
+		},
+		"treat_redirect_as_failure": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Fail the monitor check if redirected. Only applies to SIMPLE monitors.",
+		},
+		"enable_screenshot_on_failure_and_script": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Capture a screenshot during job execution. Only applies to BROWSER monitors.",
+		},
+		"runtime_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The runtime type that the monitors will run. Only applies to BROWSER monitors.",
+		},
+		"runtime_type_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The specific version of the runtime type selected. Only applies to BROWSER monitors.",
+		},
+		"script_language": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The programing language that should execute the script. Only applies to BROWSER monitors.",
+		},
+		"browsers": browsersSchema,
+		"devices":  devicesSchema,
+	}
+}
+
+// Validates the template and forces an update of the set whenever a monitor
+// of the set is not backed by a monitor in New Relic, or failed to be updated
+// previously, so that such monitors are retried on the next apply.
+func validateSyntheticsMonitorSet(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if template, ok := d.GetOk("template.0"); ok {
+		cfg := template.(map[string]interface{})
+		public := cfg["locations_public"].(*schema.Set).Len()
+		private := cfg["locations_private"].(*schema.Set).Len()
+		if public == 0 && private == 0 {
+			return fmt.Errorf("at least one of `locations_public` or `locations_private` must be specified in `template`")
+		}
+	}
+
+	return syntheticsMonitorSet.customizeDiff(d)
+}
+
+func resourceNewRelicSyntheticsMonitorSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+
+	d.SetId(id.UniqueId())
+	_ = d.Set("account_id", accountID)
+
+	monitors := d.Get("monitors").(map[string]interface{})
+	toCreate, _, _ := diffKeyedSet(map[string]interface{}{}, map[string]interface{}{}, map[string]interface{}{}, monitors, false)
+
+	log.Printf("[INFO] Creating %d New Relic Synthetics monitors in set %s", len(toCreate), d.Id())
+
+	return applySyntheticsMonitorSet(ctx, d, providerConfig, map[string]interface{}{}, toCreate, nil, nil)
+}
+
+func resourceNewRelicSyntheticsMonitorSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Reading New Relic Synthetics monitor set %s", d.Id())
+
+	guids := d.Get("monitor_guids").(map[string]interface{})
+	monitors := d.Get("monitors").(map[string]interface{})
+
+	namesByGUID := map[string]string{}
+	guidList := make([]common.EntityGUID, 0, len(guids))
+	for name, guid := range guids {
+		namesByGUID[guid.(string)] = name
+		guidList = append(guidList, common.EntityGUID(guid.(string)))
+	}
+
+	found := map[string]*entities.SyntheticMonitorEntity{}
+	for start := 0; start < len(guidList); start += entitiesBatchSize {
+		end := start + entitiesBatchSize
+		if end > len(guidList) {
+			end = len(guidList)
+		}
+
+		batch, err := getSyntheticsMonitorSetEntities(ctx, client, guidList[start:end])
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for guid, monitor := range batch {
+			found[guid] = monitor
+		}
+	}
+
+	updatedGUIDs := map[string]interface{}{}
+	updatedIDs := map[string]interface{}{}
+	for guid, name := range namesByGUID {
+		monitor, ok := found[guid]
+		if !ok {
+			log.Printf("[WARN] Synthetics monitor %s (%s) of set %s no longer exists", name, guid, d.Id())
+			continue
+		}
+
+		updatedGUIDs[name] = guid
+		updatedIDs[name] = monitor.MonitorId
+		if _, ok := monitors[name]; ok {
+			monitors[name] = monitor.MonitoredURL
+		}
+	}
+
+	_ = d.Set("monitor_guids", updatedGUIDs)
+	_ = d.Set("monitor_ids", updatedIDs)
+	_ = d.Set("monitors", monitors)
+
+	return nil
+}
+
+// Returns the monitors found among the GUIDs. The whole request fails with NotFound when
+// any of the GUIDs is not found, so the GUIDs are then looked up one by one, to only leave
+// out the monitors which no longer exist.
+func getSyntheticsMonitorSetEntities(ctx context.Context, client *newrelic.NewRelic, guids []common.EntityGUID) (map[string]*entities.SyntheticMonitorEntity, error) {
+	found := map[string]*entities.SyntheticMonitorEntity{}
+
+	resp, err := client.Entities.GetEntitiesWithContext(ctx, guids)
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); !ok {
+			return nil, err
+		}
+
+		if len(guids) == 1 {
+			return found, nil
+		}
+
+		for _, guid := range guids {
+			monitor, err := getSyntheticsMonitorSetEntities(ctx, client, []common.EntityGUID{guid})
+			if err != nil {
+				return nil, err
+			}
+			for g, m := range monitor {
+				found[g] = m
+			}
+		}
+
+		return found, nil
+	}
+
+	for _, e := range *resp {
+		if monitor, ok := e.(*entities.SyntheticMonitorEntity); ok {
+			found[string(monitor.GUID)] = monitor
+		}
+	}
+
+	return found, nil
+}
+
+func resourceNewRelicSyntheticsMonitorSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	guids := d.Get("monitor_guids").(map[string]interface{})
+	failed := d.Get("failed_monitors").(map[string]interface{})
+	oldMonitors, newMonitors := d.GetChange("monitors")
+
+	toCreate, toUpdate, toDelete := diffKeyedSet(
+		guids,
+		failed,
+		oldMonitors.(map[string]interface{}),
+		newMonitors.(map[string]interface{}),
+		d.HasChange("template"),
+	)
+
+	log.Printf("[INFO] Updating New Relic Synthetics monitor set %s: %d to create, %d to update, %d to delete", d.Id(), len(toCreate), len(toUpdate), len(toDelete))
+
+	return applySyntheticsMonitorSet(ctx, d, providerConfig, guids, toCreate, toUpdate, toDelete)
+}
+
+func resourceNewRelicSyntheticsMonitorSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	guids := d.Get("monitor_guids").(map[string]interface{})
+	toDelete := make([]string, 0, len(guids))
+	for name := range guids {
+		toDelete = append(toDelete, name)
+	}
+	sort.Strings(toDelete)
+
+	log.Printf("[INFO] Deleting %d New Relic Synthetics monitors in set %s", len(toDelete), d.Id())
+
+	diags := applySyntheticsMonitorSet(ctx, d, providerConfig, guids, nil, nil, toDelete)
+
+	// Monitors which could not be deleted are still tracked in state, so the
+	// whole set is kept until every monitor in it has been deleted.
+	if remaining := d.Get("monitor_guids").(map[string]interface{}); len(remaining) > 0 {
+		return append(diags, diag.Errorf("%d synthetics monitors in the set could not be deleted", len(remaining))...)
+	}
+
+	return diags
+}
+
+// Creates, updates and deletes the given monitors of the set, then sets the IDs of the
+// monitors from their GUIDs.
+func applySyntheticsMonitorSet(
+	ctx context.Context,
+	d *schema.ResourceData,
+	providerConfig *ProviderConfig,
+	existingGUIDs map[string]interface{},
+	toCreate []string,
+	toUpdate []string,
+	toDelete []string,
+) diag.Diagnostics {
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	monitors := d.Get("monitors").(map[string]interface{})
+	template := expandSyntheticsMonitorSetTemplate(d.Get("template.0").(map[string]interface{}))
+
+	diags := syntheticsMonitorSet.apply(ctx, d, d.Get("concurrency").(int), existingGUIDs, toCreate, toUpdate, toDelete, keyedSetOperations{
+		create: func(ctx context.Context, name string) (string, error) {
+			uri := monitors[name].(string)
+
+			var resp *synthetics.SyntheticsSimpleBrowserMonitorCreateMutationResult
+			var err error
+			if template.Type == string(SyntheticsMonitorTypes.BROWSER) {
+				resp, err = client.Synthetics.SyntheticsCreateSimpleBrowserMonitorWithContext(ctx, accountID, buildSyntheticsMonitorSetSimpleBrowserMonitorInput(template, name, uri))
+			} else {
+				resp, err = client.Synthetics.SyntheticsCreateSimpleMonitorWithContext(ctx, accountID, buildSyntheticsMonitorSetSimpleMonitorInput(template, name, uri))
+			}
+			if err != nil {
+				return "", err
+			}
+
+			if errs := buildCreateSyntheticsMonitorResponseErrors(resp.Errors); len(errs) > 0 {
+				return "", keyedSetObjectError{diags: errs}
+			}
+
+			return string(resp.Monitor.GUID), nil
+		},
+		update: func(ctx context.Context, name string, guid string) error {
+			uri := monitors[name].(string)
+
+			if template.Type == string(SyntheticsMonitorTypes.BROWSER) {
+				resp, err := client.Synthetics.SyntheticsUpdateSimpleBrowserMonitorWithContext(ctx, synthetics.EntityGUID(guid), buildSyntheticsMonitorSetSimpleBrowserMonitorUpdateInput(template, name, uri))
+				if err != nil {
+					return err
+				}
+				if errs := buildUpdateSyntheticsMonitorResponseErrors(resp.Errors); len(errs) > 0 {
+					return keyedSetObjectError{diags: errs}
+				}
+				return nil
+			}
+
+			resp, err := client.Synthetics.SyntheticsUpdateSimpleMonitorWithContext(ctx, synthetics.EntityGUID(guid), buildSyntheticsMonitorSetSimpleMonitorUpdateInput(template, name, uri))
+			if err != nil {
+				return err
+			}
+			if errs := buildUpdateSyntheticsMonitorResponseErrors(resp.Errors); len(errs) > 0 {
+				return keyedSetObjectError{diags: errs}
+			}
+
+			return nil
+		},
+		delete: func(ctx context.Context, name string, guid string) error {
+			_, err := client.Synthetics.SyntheticsDeleteMonitorWithContext(ctx, synthetics.EntityGUID(guid))
+			return err
+		},
+	})
+
+	ids := map[string]interface{}{}
+	for name, guid := range d.Get("monitor_guids").(map[string]interface{}) {
+		monitorID, err := getMonitorID(guid.(string))
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		ids[name] = monitorID
+	}
+
+	_ = d.Set("monitor_ids", ids)
+
+	return diags
+}
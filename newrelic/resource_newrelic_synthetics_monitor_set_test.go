@@ -0,0 +1,128 @@
+//go:build integration || SYNTHETICS
+// +build integration SYNTHETICS
+
+package newrelic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+)
+
+func TestAccNewRelicSyntheticsMonitorSet(t *testing.T) {
+	resourceName := "newrelic_synthetics_monitor_set.foo"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsMonitorSetDestroy,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccNewRelicSyntheticsMonitorSetConfig(rName, "EVERY_HOUR", `"https://www.example.com", "https://www.example.org"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor_guids.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "failed_monitors.%", "0"),
+				),
+			},
+			// Update the template and remove a monitor
+			{
+				Config: testAccNewRelicSyntheticsMonitorSetConfig(rName, "EVERY_6_HOURS", `"https://www.example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor_guids.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicSyntheticsMonitorSetConfig(name string, period string, uris string) string {
+	return fmt.Sprintf(`
+locals {
+  uris = [%[3]s]
+}
+
+resource "newrelic_synthetics_monitor_set" "foo" {
+  monitors = { for i, uri in local.uris : "%[1]s-${i}" => uri }
+
+  template {
+    type             = "SIMPLE"
+    period           = "%[2]s"
+    status           = "ENABLED"
+    locations_public = ["AP_SOUTH_1"]
+    verify_ssl       = true
+
+    tag {
+      key    = "tf-test"
+      values = ["tf-acc-test"]
+    }
+  }
+}
+`, name, period, uris)
+}
+
+func testAccCheckNewRelicSyntheticsMonitorSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no synthetics monitor set ID is set")
+		}
+
+		client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+		// Unfortunately we still have to wait due to async delay with entity indexing :(
+		time.Sleep(60 * time.Second)
+
+		for key, guid := range rs.Primary.Attributes {
+			if key == "monitor_guids.%" || !strings.HasPrefix(key, "monitor_guids.") {
+				continue
+			}
+
+			result, err := client.Entities.GetEntity(common.EntityGUID(guid))
+			if err != nil {
+				return err
+			}
+			if *result == nil {
+				return fmt.Errorf("synthetics monitor %s of the set was not found", guid)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNewRelicSyntheticsMonitorSetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_synthetics_monitor_set" {
+			continue
+		}
+
+		// Unfortunately we still have to wait due to async delay with entity indexing :(
+		time.Sleep(60 * time.Second)
+
+		for key, guid := range r.Primary.Attributes {
+			if key == "monitor_guids.%" || !strings.HasPrefix(key, "monitor_guids.") {
+				continue
+			}
+
+			found, _ := client.Entities.GetEntity(common.EntityGUID(guid))
+			if found != nil && (*found) != nil {
+				return fmt.Errorf("synthetics monitor %s of the set still exists", guid)
+			}
+		}
+	}
+	return nil
+}
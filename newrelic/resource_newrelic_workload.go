@@ -80,105 +80,14 @@ func resourceNewRelicWorkload() *schema.Resource {
 				Optional:    true,
 				Description: "An input object used to represent an automatic status configuration.",
 				MaxItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"enabled": {
-							Type:        schema.TypeBool,
-							Required:    true,
-							Description: "Whether the automatic status configuration is enabled or not.",
-						},
-						"remaining_entities_rule": {
-							Type:        schema.TypeSet,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "An additional meta-rule that can consider all entities that haven't been evaluated by any other rule.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"remaining_entities_rule_rollup": {
-										Type:        schema.TypeSet,
-										Required:    true,
-										MaxItems:    1,
-										Description: "The input object used to represent a rollup strategy.",
-										Elem:        WorkloadremainingEntitiesRuleSchemaElem(),
-									},
-								},
-							},
-						},
-						"rule": {
-							Type:        schema.TypeSet,
-							Optional:    true,
-							Description: "A list of rules.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"entity_guids": {
-										Type:        schema.TypeSet,
-										Optional:    true,
-										Computed:    true,
-										Description: "A list of entity GUIDs composing the rule.",
-										Elem:        &schema.Schema{Type: schema.TypeString},
-									},
-									"nrql_query": {
-										Type:        schema.TypeSet,
-										Optional:    true,
-										Description: "A list of entity search queries used to retrieve the entities that compose the rule.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"query": {
-													Type:        schema.TypeString,
-													Required:    true,
-													Description: "The entity search query that is used to perform the search of a group of entities.",
-													ValidateFunc: validation.All(
-														validation.StringIsNotEmpty,
-														validation.StringIsNotWhiteSpace,
-														validation.NoZeroValues,
-													),
-												},
-											},
-										},
-									},
-									"rollup": {
-										Type:        schema.TypeSet,
-										Required:    true,
-										MaxItems:    1,
-										Description: "The input object used to represent a rollup strategy.",
-										Elem:        WorkloadRuleRollupInputSchemaElem(),
-									},
-								},
-							},
-						},
-					},
-				},
+				Elem:        WorkloadStatusConfigAutomaticSchemaElem(),
 			},
 			"status_config_static": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				MaxItems:    1,
 				Description: "A list of static status configurations. You can only configure one static status for a workload.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"description": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "A description that provides additional details about the status of the workload.",
-						},
-						"enabled": {
-							Type:        schema.TypeBool,
-							Required:    true,
-							Description: "Whether the static status configuration is enabled or not.",
-						},
-						"status": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "The status of the workload.",
-							ValidateFunc: validation.StringInSlice(listValidWorkloadStatuses(), false),
-						},
-						"summary": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "A short description of the status of the workload.",
-						},
-					},
-				},
+				Elem:        WorkloadStatusConfigStaticSchemaElem(),
 			},
 			"workload_id": {
 				Type:        schema.TypeInt,
@@ -204,6 +113,105 @@ func resourceNewRelicWorkload() *schema.Resource {
 	}
 }
 
+func WorkloadStatusConfigAutomaticSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the automatic status configuration is enabled or not.",
+			},
+			"remaining_entities_rule": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "An additional meta-rule that can consider all entities that haven't been evaluated by any other rule.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"remaining_entities_rule_rollup": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MaxItems:    1,
+							Description: "The input object used to represent a rollup strategy.",
+							Elem:        WorkloadremainingEntitiesRuleSchemaElem(),
+						},
+					},
+				},
+			},
+			"rule": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of rules.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_guids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Description: "A list of entity GUIDs composing the rule.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"nrql_query": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "A list of entity search queries used to retrieve the entities that compose the rule.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The entity search query that is used to perform the search of a group of entities.",
+										ValidateFunc: validation.All(
+											validation.StringIsNotEmpty,
+											validation.StringIsNotWhiteSpace,
+											validation.NoZeroValues,
+										),
+									},
+								},
+							},
+						},
+						"rollup": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MaxItems:    1,
+							Description: "The input object used to represent a rollup strategy.",
+							Elem:        WorkloadRuleRollupInputSchemaElem(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func WorkloadStatusConfigStaticSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A description that provides additional details about the status of the workload.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the static status configuration is enabled or not.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The status of the workload.",
+				ValidateFunc: validation.StringInSlice(listValidWorkloadStatuses(), false),
+			},
+			"summary": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A short description of the status of the workload.",
+			},
+		},
+	}
+}
+
 func WorkloadRuleRollupInputSchemaElem() *schema.Resource {
 	s := WorkloadRollupInputSchemaElem()
 	return &schema.Resource{
@@ -268,7 +276,7 @@ func resourceNewRelicWorkloadCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	d.SetId(ids.String())
 
-	return resourceNewRelicWorkloadRead(ctx, d, meta)
+	return append(workloadStatusRulesOverlapDiagnostics(d), resourceNewRelicWorkloadRead(ctx, d, meta)...)
 }
 
 func resourceNewRelicWorkloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -305,7 +313,18 @@ func resourceNewRelicWorkloadUpdate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(ids.String())
 
-	return resourceNewRelicWorkloadRead(ctx, d, meta)
+	return append(workloadStatusRulesOverlapDiagnostics(d), resourceNewRelicWorkloadRead(ctx, d, meta)...)
+}
+
+// New Relic accepts rules which overlap along with a remaining entities rule, so the
+// overlap is reported as warnings once the workload is saved, as a plan can only fail.
+// The check is best-effort: it only compares the entity GUIDs and the entity search
+// queries as configured, without resolving the queries to the entities they match,
+// which the newrelic_workload_status_preview data source does.
+func workloadStatusRulesOverlapDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	automatic := expandWorkloadStatusConfigAutomaticInput(d.Get("status_config_automatic").(*schema.Set).List())
+
+	return workloadStatusRulesOverlapWarnings(automatic, workloadStatusRuleGUIDs(automatic))
 }
 
 func resourceNewRelicWorkloadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
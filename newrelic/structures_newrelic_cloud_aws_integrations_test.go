@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandCloudAwsIntegrationsInput_CatalogServices(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicCloudAwsIntegrations().Schema, map[string]interface{}{
+		"linked_account_id": 123,
+		"aws_athena": []interface{}{
+			map[string]interface{}{
+				"aws_regions":              []interface{}{"us-east-1", "eu-west-1"},
+				"metrics_polling_interval": 300,
+			},
+		},
+	})
+
+	configureInput, disableInput := expandCloudAwsIntegrationsInput(d)
+
+	require.Equal(t, []cloud.CloudAwsAthenaIntegrationInput{{
+		LinkedAccountId:        123,
+		AwsRegions:             []string{"us-east-1", "eu-west-1"},
+		MetricsPollingInterval: 300,
+	}}, configureInput.Aws.AwsAthena)
+	require.Empty(t, configureInput.Aws.AwsGlue)
+	require.Empty(t, disableInput.Aws.AwsAthena)
+
+	deleteInput := buildDeleteInput(d)
+	require.Equal(t, []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: 123}}, deleteInput.Aws.AwsAthena)
+	require.Empty(t, deleteInput.Aws.AwsGlue)
+}
+
+func TestFlattenCloudAwsLinkedAccount_CatalogServices(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicCloudAwsIntegrations().Schema, map[string]interface{}{})
+
+	flattenCloudAwsLinkedAccount(d, &cloud.CloudLinkedAccount{
+		ID: 123,
+		Integrations: []cloud.CloudIntegrationInterface{
+			&cloud.CloudAwsAthenaIntegration{AwsRegions: []string{"us-east-1"}, MetricsPollingInterval: 600},
+			&cloud.CloudBillingIntegration{MetricsPollingInterval: 3600},
+		},
+	})
+
+	require.Equal(t, "us-east-1", d.Get("aws_athena.0.aws_regions.0"))
+	require.Equal(t, 600, d.Get("aws_athena.0.metrics_polling_interval"))
+	require.Equal(t, 3600, d.Get("billing.0.metrics_polling_interval"))
+}
+
+func TestCloudAwsGovCloudCatalogIntegrations(t *testing.T) {
+	govCloudSchema := cloudAwsGovCloudCatalogIntegrationsSchema()
+	awsSchema := cloudAwsCatalogIntegrationsSchema()
+
+	require.NotEmpty(t, govCloudSchema)
+	for key, s := range govCloudSchema {
+		require.Contains(t, awsSchema, key)
+		require.Equal(t, awsSchema[key].Description, s.Description)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicAwsGovCloudIntegrations().Schema, map[string]interface{}{
+		"linked_account_id": 123,
+		"aws_states": []interface{}{
+			map[string]interface{}{
+				"metrics_polling_interval": 900,
+			},
+		},
+	})
+
+	configureInput, _ := expandAwsGovCloudIntegrationsInput(d)
+	require.Equal(t, []cloud.CloudAwsStatesIntegrationInput{{
+		LinkedAccountId:        123,
+		AwsRegions:             []string{},
+		MetricsPollingInterval: 900,
+	}}, configureInput.AwsGovcloud.AwsStates)
+}
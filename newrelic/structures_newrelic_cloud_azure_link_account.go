@@ -0,0 +1,17 @@
+package newrelic
+
+import (
+	"context"
+)
+
+// migrateStateNewRelicCloudAzureLinkAccountV0toV1 replaces the `client_secret`
+// kept in state by its HMAC keyed on the linked account ID, which is all the state
+// holds from version 1 on.
+func migrateStateNewRelicCloudAzureLinkAccountV0toV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if secret, ok := rawState["client_secret"].(string); ok && secret != "" {
+		id, _ := rawState["id"].(string)
+		rawState["client_secret"] = hmacCloudLinkAccountSecret(id, secret)
+	}
+
+	return rawState, nil
+}
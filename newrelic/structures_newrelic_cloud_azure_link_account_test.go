@@ -0,0 +1,51 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudAzureLinkAccountStateUpgradeV0(t *testing.T) {
+	actual, err := migrateStateNewRelicCloudAzureLinkAccountV0toV1(nil, map[string]interface{}{
+		"id":            "123",
+		"name":          "production",
+		"client_secret": "secret",
+	}, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"id":            "123",
+		"name":          "production",
+		"client_secret": hmacCloudLinkAccountSecret("123", "secret"),
+	}, actual)
+}
+
+func TestCloudAzureLinkAccountSecret(t *testing.T) {
+	d := resourceNewRelicCloudAzureLinkAccount().TestResourceData()
+	require.NoError(t, d.Set("client_secret", "secret"))
+
+	// Without a configuration, the secret is read from the resource data.
+	require.Equal(t, "secret", getCloudLinkAccountSecret(d, "client_secret"))
+	require.Equal(t, "secret", getCloudLinkAccountSecret(testCloudLinkAccountsGetter{"client_secret": "secret"}, "client_secret"))
+}
+
+func TestCloudAzureLinkAccountSecretHMAC(t *testing.T) {
+	// The HMAC depends on the linked account, so the same secret differs across accounts.
+	require.Equal(t, "2100628d5a6c27d0cf342603f51d19dd404278267fce707dc2b55d2406642b0a", hmacCloudLinkAccountSecret("123", "secret"))
+	require.NotEqual(t, hmacCloudLinkAccountSecret("123", "secret"), hmacCloudLinkAccountSecret("456", "secret"))
+
+	d := resourceNewRelicCloudAzureLinkAccount().TestResourceData()
+	require.False(t, suppressCloudLinkAccountSecretDiff("client_secret", "", "secret", d))
+
+	d.SetId("123")
+	require.NoError(t, d.Set("client_secret", "secret"))
+	setCloudLinkAccountSecret(d, "client_secret")
+	require.Equal(t, hmacCloudLinkAccountSecret("123", "secret"), d.Get("client_secret"))
+
+	require.True(t, suppressCloudLinkAccountSecretDiff("client_secret", d.Get("client_secret").(string), "secret", d))
+	require.False(t, suppressCloudLinkAccountSecretDiff("client_secret", d.Get("client_secret").(string), "rotated", d))
+}
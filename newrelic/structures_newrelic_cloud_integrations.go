@@ -0,0 +1,139 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Imports the integrations of a linked account from the ID of the linked account,
+// or from `<account_id>:<linked_account_id>` when the cloud account is linked to
+// another New Relic account than the one of the provider. The settings of every
+// enabled integration are read by the Read function of the resource.
+func importCloudIntegrations(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	linkedAccountID := d.Id()
+
+	if parts := strings.Split(d.Id(), ":"); len(parts) == 2 {
+		accountID, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid account ID %q in import ID %q, expected <account_id>:<linked_account_id>", parts[0], d.Id())
+		}
+
+		_ = d.Set("account_id", accountID)
+		linkedAccountID = parts[1]
+	}
+
+	if _, err := strconv.Atoi(linkedAccountID); err != nil {
+		return nil, fmt.Errorf("invalid linked account ID %q in import ID %q", linkedAccountID, d.Id())
+	}
+
+	d.SetId(linkedAccountID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// The metrics polling intervals in seconds a cloud integration accepts, and the one it
+// is polled at when none is configured.
+type cloudIntegrationMetricsPollingIntervals struct {
+	intervals       []int
+	defaultInterval int
+}
+
+func (p cloudIntegrationMetricsPollingIntervals) accepts(interval int) bool {
+	for _, i := range p.intervals {
+		if i == interval {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the metrics polling intervals of an AWS service, as listed in the catalog.
+func cloudAwsMetricsPollingIntervals(service string) cloudIntegrationMetricsPollingIntervals {
+	if p, ok := cloudAwsServiceMetricsPollingIntervals[service]; ok {
+		return p
+	}
+
+	return cloudAwsDefaultMetricsPollingIntervals
+}
+
+// Returns the keys of the blocks of the services of a cloud integrations resource.
+func cloudIntegrationsServiceKeys(s map[string]*schema.Schema) []string {
+	keys := []string{}
+	for key, attr := range s {
+		if _, ok := attr.Elem.(*schema.Resource); ok && attr.Type == schema.TypeList {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Sets the services blocks of a cloud integrations resource from the integrations
+// enabled on the linked account, through `flatten`. The blocks of the services which
+// are not enabled any more are removed from state.
+//
+// Services enabled outside of Terraform since the last refresh are reported as
+// warnings, as the next apply disables them unless they are added to the configuration.
+// While importing, when the state holds no linked account yet, a single warning lists
+// every imported service for the same reason.
+func readCloudIntegrations(d *schema.ResourceData, keys []string, flatten func()) diag.Diagnostics {
+	importing := d.Get("linked_account_id").(int) == 0
+
+	managed := map[string]bool{}
+	for _, key := range keys {
+		managed[key] = len(d.Get(key).([]interface{})) > 0
+		_ = d.Set(key, nil)
+	}
+
+	flatten()
+
+	if importing {
+		imported := []string{}
+		for _, key := range keys {
+			if len(d.Get(key).([]interface{})) > 0 {
+				imported = append(imported, key)
+			}
+		}
+
+		if len(imported) == 0 {
+			return nil
+		}
+
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d integrations imported from linked account %s", len(imported), d.Id()),
+			Detail: fmt.Sprintf(
+				"The following integrations are enabled on the linked account: %s. Any of them missing from the configuration will be disabled on the next apply.",
+				strings.Join(imported, ", "),
+			),
+		}}
+	}
+
+	var diags diag.Diagnostics
+	for _, key := range keys {
+		if managed[key] || len(d.Get(key).([]interface{})) == 0 {
+			continue
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The %q integration is enabled outside of Terraform", key),
+			Detail: fmt.Sprintf(
+				"The %q integration is enabled on linked account %d, but missing from the configuration. It will be disabled on the next apply unless a %q block is added to the configuration.",
+				key,
+				d.Get("linked_account_id").(int),
+				key,
+			),
+		})
+	}
+
+	return diags
+}
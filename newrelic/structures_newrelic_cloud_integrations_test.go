@@ -0,0 +1,103 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportCloudIntegrations(t *testing.T) {
+	d := resourceNewRelicCloudAwsIntegrations().Data(nil)
+	d.SetId("12345:678")
+
+	result, err := importCloudIntegrations(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "678", d.Id())
+	require.Equal(t, 12345, d.Get("account_id"))
+
+	d = resourceNewrelicCloudGcpIntegrations().Data(nil)
+	d.SetId("678")
+
+	_, err = importCloudIntegrations(context.Background(), d, nil)
+	require.NoError(t, err)
+	require.Equal(t, "678", d.Id())
+	require.Equal(t, 0, d.Get("account_id"))
+
+	d.SetId("abc:678")
+	_, err = importCloudIntegrations(context.Background(), d, nil)
+	require.Error(t, err)
+
+	d.SetId("abc")
+	_, err = importCloudIntegrations(context.Background(), d, nil)
+	require.Error(t, err)
+}
+
+func TestCloudIntegrationsServiceKeys(t *testing.T) {
+	keys := cloudIntegrationsServiceKeys(resourceNewRelicCloudAwsIntegrations().Schema)
+
+	require.Contains(t, keys, "ec2")
+	require.Contains(t, keys, "aws_athena")
+	require.NotContains(t, keys, "account_id")
+	require.NotContains(t, keys, "linked_account_id")
+	require.IsIncreasing(t, keys)
+}
+
+func TestReadCloudIntegrations(t *testing.T) {
+	r := resourceNewRelicCloudAwsIntegrations()
+	keys := cloudIntegrationsServiceKeys(r.Schema)
+	linkedAccount := &cloud.CloudLinkedAccount{
+		ID:          678,
+		NrAccountId: 12345,
+		Integrations: []cloud.CloudIntegrationInterface{
+			&cloud.CloudEc2Integration{MetricsPollingInterval: 300},
+			&cloud.CloudLambdaIntegration{MetricsPollingInterval: 300},
+		},
+	}
+
+	// Importing: every enabled service is read, and listed in a single warning.
+	d := r.Data(nil)
+	d.SetId("678")
+
+	diags := readCloudIntegrations(d, keys, func() { flattenCloudAwsLinkedAccount(d, linkedAccount) })
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Contains(t, diags[0].Detail, "ec2, lambda")
+	require.Equal(t, 300, d.Get("ec2.0.metrics_polling_interval"))
+	require.Equal(t, 300, d.Get("lambda.0.metrics_polling_interval"))
+
+	// Refreshing: lambda was enabled outside of Terraform, and rds was disabled.
+	d = r.Data(nil)
+	d.SetId("678")
+	require.NoError(t, d.Set("linked_account_id", 678))
+	require.NoError(t, d.Set("ec2", []interface{}{map[string]interface{}{"metrics_polling_interval": 300}}))
+	require.NoError(t, d.Set("rds", []interface{}{map[string]interface{}{"metrics_polling_interval": 300}}))
+
+	diags = readCloudIntegrations(d, keys, func() { flattenCloudAwsLinkedAccount(d, linkedAccount) })
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Contains(t, diags[0].Summary, `"lambda"`)
+	require.Empty(t, d.Get("rds"))
+}
+func TestCloudAwsMetricsPollingIntervals(t *testing.T) {
+	require.Equal(t, cloudAwsDefaultMetricsPollingIntervals, cloudAwsMetricsPollingIntervals("ec2"))
+	require.True(t, cloudAwsMetricsPollingIntervals("ec2").accepts(300))
+	require.False(t, cloudAwsMetricsPollingIntervals("ec2").accepts(86400))
+
+	require.Equal(t, 3600, cloudAwsMetricsPollingIntervals("billing").defaultInterval)
+	require.True(t, cloudAwsMetricsPollingIntervals("billing").accepts(86400))
+	require.False(t, cloudAwsMetricsPollingIntervals("billing").accepts(300))
+
+	// Every service listed in the catalog is a service of newrelic_cloud_aws_integrations.
+	services := cloudIntegrationsServiceKeys(resourceNewRelicCloudAwsIntegrations().Schema)
+	for service, p := range cloudAwsServiceMetricsPollingIntervals {
+		require.Contains(t, services, service)
+		require.True(t, p.accepts(p.defaultInterval), service)
+	}
+}
@@ -0,0 +1,337 @@
+package newrelic
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+)
+
+// Describes how the accounts of a cloud provider are linked, updated and read
+// by the `newrelic_cloud_*_link_accounts` resources, which link many accounts
+// of the same provider from a map of account names to account identifiers.
+type cloudLinkAccountsProvider struct {
+	// The provider slug used to list the linked accounts, e.g. `aws`.
+	slug string
+	// The attributes shared by every account of the set which can be updated in
+	// place. A change to any of them updates every linked account of the set.
+	sharedAttributes []string
+	// The shared attributes holding secrets, of which only an HMAC is kept in state.
+	secretAttributes []string
+	// Builds the input to link the account `name` identified by `value`.
+	linkInput func(d cloudLinkAccountsGetter, name string, value string) cloud.CloudLinkCloudAccountsInput
+	// Builds the input to update the linked account `linkedAccountID`.
+	updateInput func(d cloudLinkAccountsGetter, linkedAccountID int, name string, value string) cloud.CloudUpdateCloudAccountsInput
+	// Returns the identifier of a linked account, matching the values of the `accounts` map.
+	value func(linkedAccount *cloud.CloudLinkedAccount) string
+}
+
+// Satisfied by both *schema.ResourceData and *schema.ResourceDiff.
+type cloudLinkAccountsGetter interface {
+	Get(key string) interface{}
+}
+
+// Returns the HMAC of the secret of a linked account keyed on the ID of the resource,
+// which is all that is kept of the secret in state. Unlike a bare hash, it cannot be
+// looked up in a table of hashes of known secrets.
+func hmacCloudLinkAccountSecret(id string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(id))
+	mac.Write([]byte(secret))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Suppresses the diff of a secret whose HMAC matches the one kept in state. Changing
+// the secret in the configuration still shows up in the plan, and updates the linked
+// accounts in place.
+func suppressCloudLinkAccountSecretDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == hmacCloudLinkAccountSecret(d.Id(), new)
+}
+
+// Replaces the secret configured for `key` by its HMAC, once the ID of the resource is known.
+func setCloudLinkAccountSecret(d *schema.ResourceData, key string) {
+	if secret := getCloudLinkAccountSecret(d, key); secret != "" && d.Id() != "" {
+		_ = d.Set(key, hmacCloudLinkAccountSecret(d.Id(), secret))
+	}
+}
+
+// Returns the secret configured for `key`. As only the HMAC of the secret is kept in
+// state, the secret is read from the configuration whenever it is available.
+func getCloudLinkAccountSecret(d cloudLinkAccountsGetter, key string) string {
+	if r, ok := d.(interface{ GetRawConfig() cty.Value }); ok {
+		config := r.GetRawConfig()
+		if config.IsKnown() && !config.IsNull() && config.Type().HasAttribute(key) {
+			if v := config.GetAttr(key); v.IsKnown() && !v.IsNull() {
+				return v.AsString()
+			}
+		}
+	}
+
+	return d.Get(key).(string)
+}
+
+func cloudLinkAccountsSchema(accountsDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The New Relic account ID where you want to link the cloud accounts.",
+		},
+		"accounts": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Required:    true,
+			Description: accountsDescription,
+		},
+		"concurrency": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 50),
+			Description:  "The maximum number of accounts linked, updated or unlinked at the same time.",
+		},
+		"linked_account_ids": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "A map of account names to the IDs of the linked accounts in New Relic.",
+		},
+		"failed_accounts": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "A map of account names to the error returned by the last failed link, update or unlink of the account.",
+		},
+	}
+}
+
+// The linked accounts of the set, linked from the map of account names to identifiers.
+func (p cloudLinkAccountsProvider) set() keyedSet {
+	return keyedSet{
+		items:       "accounts",
+		ids:         "linked_account_ids",
+		failed:      "failed_accounts",
+		shared:      p.sharedAttributes,
+		description: p.slug + " account",
+	}
+}
+
+// Forces an update of the set whenever an account of the set is not linked, or
+// failed to be updated previously, so that such accounts are retried on the next apply.
+func (p cloudLinkAccountsProvider) customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return p.set().customizeDiff(d)
+}
+
+func (p cloudLinkAccountsProvider) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+
+	d.SetId(id.UniqueId())
+	_ = d.Set("account_id", accountID)
+
+	accounts := d.Get("accounts").(map[string]interface{})
+	toLink, _, _ := diffKeyedSet(map[string]interface{}{}, map[string]interface{}{}, map[string]interface{}{}, accounts, false)
+
+	log.Printf("[INFO] Linking %d %s accounts in set %s", len(toLink), p.slug, d.Id())
+
+	diags := p.apply(ctx, d, providerConfig, map[string]interface{}{}, toLink, nil, nil)
+	p.setSecrets(d)
+
+	return diags
+}
+
+func (p cloudLinkAccountsProvider) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading %s linked accounts set %s", p.slug, d.Id())
+
+	found := map[int]cloud.CloudLinkedAccount{}
+	linkedAccounts, err := client.Cloud.GetLinkedAccountsWithContext(ctx, p.slug)
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); !ok {
+			return diag.FromErr(err)
+		}
+	} else {
+		for _, a := range *linkedAccounts {
+			if a.NrAccountId == accountID {
+				found[a.ID] = a
+			}
+		}
+	}
+
+	ids := d.Get("linked_account_ids").(map[string]interface{})
+	accounts := d.Get("accounts").(map[string]interface{})
+
+	updatedIDs := map[string]interface{}{}
+	for name, linkedAccountID := range ids {
+		parsedID, _ := strconv.Atoi(linkedAccountID.(string))
+
+		linkedAccount, ok := found[parsedID]
+		if !ok {
+			log.Printf("[WARN] Linked %s account %s (%d) of set %s no longer exists", p.slug, name, parsedID, d.Id())
+			continue
+		}
+
+		updatedIDs[name] = linkedAccountID
+		if _, ok := accounts[name]; ok {
+			if value := p.value(&linkedAccount); value != "" {
+				accounts[name] = value
+			}
+		}
+	}
+
+	_ = d.Set("linked_account_ids", updatedIDs)
+	_ = d.Set("accounts", accounts)
+
+	return nil
+}
+
+func (p cloudLinkAccountsProvider) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	ids := d.Get("linked_account_ids").(map[string]interface{})
+	failed := d.Get("failed_accounts").(map[string]interface{})
+	oldAccounts, newAccounts := d.GetChange("accounts")
+
+	toLink, toUpdate, toUnlink := diffKeyedSet(
+		ids,
+		failed,
+		oldAccounts.(map[string]interface{}),
+		newAccounts.(map[string]interface{}),
+		d.HasChanges(p.sharedAttributes...),
+	)
+
+	log.Printf("[INFO] Updating %s linked accounts set %s: %d to link, %d to update, %d to unlink", p.slug, d.Id(), len(toLink), len(toUpdate), len(toUnlink))
+
+	diags := p.apply(ctx, d, providerConfig, ids, toLink, toUpdate, toUnlink)
+	p.setSecrets(d)
+
+	return diags
+}
+
+func (p cloudLinkAccountsProvider) setSecrets(d *schema.ResourceData) {
+	for _, key := range p.secretAttributes {
+		setCloudLinkAccountSecret(d, key)
+	}
+}
+
+func (p cloudLinkAccountsProvider) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	ids := d.Get("linked_account_ids").(map[string]interface{})
+	toUnlink := make([]string, 0, len(ids))
+	for name := range ids {
+		toUnlink = append(toUnlink, name)
+	}
+	sort.Strings(toUnlink)
+
+	log.Printf("[INFO] Unlinking %d %s accounts in set %s", len(toUnlink), p.slug, d.Id())
+
+	diags := p.apply(ctx, d, providerConfig, ids, nil, nil, toUnlink)
+
+	// Accounts which could not be unlinked are still tracked in state, so the
+	// whole set is kept until every account in it has been unlinked.
+	if remaining := d.Get("linked_account_ids").(map[string]interface{}); len(remaining) > 0 {
+		return append(diags, diag.Errorf("%d %s accounts in the set could not be unlinked", len(remaining), p.slug)...)
+	}
+
+	return diags
+}
+
+// Links, updates and unlinks the given accounts of the set.
+func (p cloudLinkAccountsProvider) apply(
+	ctx context.Context,
+	d *schema.ResourceData,
+	providerConfig *ProviderConfig,
+	existingIDs map[string]interface{},
+	toLink []string,
+	toUpdate []string,
+	toUnlink []string,
+) diag.Diagnostics {
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	accounts := d.Get("accounts").(map[string]interface{})
+
+	return p.set().apply(ctx, d, d.Get("concurrency").(int), existingIDs, toLink, toUpdate, toUnlink, keyedSetOperations{
+		create: func(ctx context.Context, name string) (string, error) {
+			input := p.linkInput(d, name, accounts[name].(string))
+
+			var linkedAccountID int
+			retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				payload, err := client.Cloud.CloudLinkAccountWithContext(ctx, accountID, input)
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+
+				if len(payload.Errors) > 0 {
+					err := payload.Errors[0]
+					// IAM roles take a while to propagate in AWS after being created.
+					if strings.Contains(err.Message, "The ARN you entered does not permit the correct access to your AWS account") {
+						return resource.RetryableError(fmt.Errorf("%s %s", err.Type, err.Message))
+					}
+					return resource.NonRetryableError(fmt.Errorf("%s %s", err.Type, err.Message))
+				}
+
+				if len(payload.LinkedAccounts) == 0 {
+					return resource.NonRetryableError(fmt.Errorf("no linked account returned"))
+				}
+
+				linkedAccountID = payload.LinkedAccounts[0].ID
+
+				return nil
+			})
+			if retryErr != nil {
+				return "", retryErr
+			}
+
+			return strconv.Itoa(linkedAccountID), nil
+		},
+		update: func(ctx context.Context, name string, id string) error {
+			linkedAccountID, _ := strconv.Atoi(id)
+
+			payload, err := client.Cloud.CloudUpdateAccountWithContext(ctx, accountID, p.updateInput(d, linkedAccountID, name, accounts[name].(string)))
+			if err != nil {
+				return err
+			}
+
+			if len(payload.LinkedAccounts) == 0 {
+				return fmt.Errorf("no linked account with 'linked_account_id': %d found", linkedAccountID)
+			}
+
+			return nil
+		},
+		delete: func(ctx context.Context, name string, id string) error {
+			linkedAccountID, _ := strconv.Atoi(id)
+
+			payload, err := client.Cloud.CloudUnlinkAccountWithContext(ctx, accountID, []cloud.CloudUnlinkAccountsInput{
+				{LinkedAccountId: linkedAccountID},
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(payload.Errors) > 0 {
+				return fmt.Errorf("%s %s", payload.Errors[0].Type, payload.Errors[0].Message)
+			}
+
+			return nil
+		},
+	})
+}
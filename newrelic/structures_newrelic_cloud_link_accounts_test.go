@@ -0,0 +1,46 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+	"github.com/stretchr/testify/require"
+)
+
+type testCloudLinkAccountsGetter map[string]interface{}
+
+func (g testCloudLinkAccountsGetter) Get(key string) interface{} {
+	return g[key]
+}
+
+func TestCloudAzureLinkAccountsInputs(t *testing.T) {
+	d := testCloudLinkAccountsGetter{"application_id": "app", "client_secret": "secret", "tenant_id": "tenant"}
+
+	linkInput := cloudAzureLinkAccounts.linkInput(d, "prod", "subscription")
+	require.Equal(t, []cloud.CloudAzureLinkAccountInput{{
+		ApplicationID:  "app",
+		ClientSecret:   "secret",
+		Name:           "prod",
+		SubscriptionId: "subscription",
+		TenantId:       "tenant",
+	}}, linkInput.Azure)
+
+	updateInput := cloudAzureLinkAccounts.updateInput(d, 123, "prod", "subscription")
+	require.Len(t, updateInput.Azure, 1)
+	require.Equal(t, 123, updateInput.Azure[0].LinkedAccountId)
+	require.Equal(t, cloud.SecureValue("secret"), updateInput.Azure[0].ClientSecret)
+}
+
+func TestCloudAwsLinkAccountsInputs(t *testing.T) {
+	d := testCloudLinkAccountsGetter{"metric_collection_mode": "push"}
+
+	linkInput := cloudAwsLinkAccounts.linkInput(d, "prod", "arn:prod")
+	require.Equal(t, []cloud.CloudAwsLinkAccountInput{{
+		Arn:                  "arn:prod",
+		MetricCollectionMode: cloud.CloudMetricCollectionModeTypes.PUSH,
+		Name:                 "prod",
+	}}, linkInput.Aws)
+}
@@ -0,0 +1,220 @@
+package newrelic
+
+import (
+	"context"
+	"sort"
+
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entityrelationship"
+)
+
+// An entity at either end of a relationship. The name and type are empty for entities
+// the user cannot access.
+type entityRelationshipVertex struct {
+	GUID   string `json:"guid"`
+	Entity *struct {
+		Name   string `json:"name"`
+		Type   string `json:"type"`
+		Domain string `json:"domain"`
+	} `json:"entity"`
+}
+
+type entityRelationshipEdge struct {
+	Typename string                   `json:"__typename"`
+	Type     string                   `json:"type"`
+	Source   entityRelationshipVertex `json:"source"`
+	Target   entityRelationshipVertex `json:"target"`
+}
+
+func (e entityRelationshipEdge) userDefined() bool {
+	return e.Typename == "EntityRelationshipUserDefinedEdge"
+}
+
+// The relationships of an entity, with the source and the target of each, which the
+// queries of the entities package leave out.
+const getEntityRelationshipsQuery = `query(
+	$guid: EntityGuid!,
+	$cursor: String,
+) { actor { entity(guid: $guid) {
+	relatedEntities(cursor: $cursor) {
+		nextCursor
+		results {
+			__typename
+			type
+			source {
+				guid
+				entity {
+					name
+					type
+					domain
+				}
+			}
+			target {
+				guid
+				entity {
+					name
+					type
+					domain
+				}
+			}
+		}
+	}
+} } }`
+
+type getEntityRelationshipsResponse struct {
+	Actor struct {
+		Entity *struct {
+			RelatedEntities struct {
+				NextCursor string                   `json:"nextCursor"`
+				Results    []entityRelationshipEdge `json:"results"`
+			} `json:"relatedEntities"`
+		} `json:"entity"`
+	} `json:"actor"`
+}
+
+func listValidEntityRelationshipTypes() []string {
+	t := entityrelationship.EntityRelationshipEdgeTypeTypes
+
+	return []string{
+		string(t.BUILT_FROM),
+		string(t.BYPASS_CALLS),
+		string(t.CALLS),
+		string(t.CONNECTS_TO),
+		string(t.CONSUMES),
+		string(t.CONTAINS),
+		string(t.HOSTS),
+		string(t.IS),
+		string(t.MANAGES),
+		string(t.MEASURES),
+		string(t.MONITORS),
+		string(t.OPERATES_IN),
+		string(t.OWNS),
+		string(t.PRODUCES),
+		string(t.SERVES),
+		string(t.TRIGGERS),
+	}
+}
+
+// Returns all the relationships of an entity, incoming and outgoing, detected and user
+// defined. The second return value is false when the entity is not found.
+func getEntityRelationships(ctx context.Context, client *newrelic.NewRelic, guid string) ([]entityRelationshipEdge, bool, error) {
+	edges := []entityRelationshipEdge{}
+	vars := map[string]interface{}{"guid": guid}
+
+	for {
+		resp := getEntityRelationshipsResponse{}
+		if err := client.NerdGraph.QueryWithResponseAndContext(ctx, getEntityRelationshipsQuery, vars, &resp); err != nil {
+			return nil, false, err
+		}
+
+		if resp.Actor.Entity == nil {
+			return nil, false, nil
+		}
+
+		edges = append(edges, resp.Actor.Entity.RelatedEntities.Results...)
+
+		if resp.Actor.Entity.RelatedEntities.NextCursor == "" {
+			return edges, true, nil
+		}
+		vars["cursor"] = resp.Actor.Entity.RelatedEntities.NextCursor
+	}
+}
+
+// An entity reached by traversing relationships, and the number of hops it is away.
+type entityRelationshipNode struct {
+	vertex entityRelationshipVertex
+	depth  int
+}
+
+// Traverses the relationships of an entity breadth first, up to `maxDepth` hops away.
+// Relationships are followed from source to target with the `outgoing` direction, from
+// target to source with `incoming`, and both ways with `both`. Only the relationships of
+// the given types are followed, or all of them without types. Returns the relationships
+// followed, and the entities reached, ordered by depth and GUID.
+func traverseEntityRelationships(ctx context.Context, start string, maxDepth int, direction string, types []string, userDefinedOnly bool, fetch func(ctx context.Context, guid string) ([]entityRelationshipEdge, error)) ([]entityRelationshipEdge, []entityRelationshipNode, error) {
+	allowed := map[string]bool{}
+	for _, t := range types {
+		allowed[t] = true
+	}
+
+	nodes := map[string]*entityRelationshipNode{
+		start: {vertex: entityRelationshipVertex{GUID: start}, depth: 0},
+	}
+	seenEdges := map[string]bool{}
+	edges := []entityRelationshipEdge{}
+
+	frontier := []string{start}
+	for depth := 1; depth <= maxDepth && len(frontier) > 0; depth++ {
+		next := []string{}
+
+		for _, guid := range frontier {
+			related, err := fetch(ctx, guid)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for _, e := range related {
+				if len(allowed) > 0 && !allowed[e.Type] {
+					continue
+				}
+				if userDefinedOnly && !e.userDefined() {
+					continue
+				}
+
+				var neighbor entityRelationshipVertex
+				switch {
+				case e.Source.GUID == guid && direction != "incoming":
+					neighbor = e.Target
+				case e.Target.GUID == guid && direction != "outgoing":
+					neighbor = e.Source
+				default:
+					continue
+				}
+
+				key := e.Source.GUID + ":" + e.Type + ":" + e.Target.GUID
+				if !seenEdges[key] {
+					seenEdges[key] = true
+					edges = append(edges, e)
+				}
+
+				if node, ok := nodes[neighbor.GUID]; ok {
+					if node.vertex.Entity == nil {
+						node.vertex.Entity = neighbor.Entity
+					}
+					continue
+				}
+
+				nodes[neighbor.GUID] = &entityRelationshipNode{vertex: neighbor, depth: depth}
+				next = append(next, neighbor.GUID)
+			}
+		}
+
+		frontier = next
+	}
+
+	reached := make([]entityRelationshipNode, 0, len(nodes)-1)
+	for guid, node := range nodes {
+		if guid != start {
+			reached = append(reached, *node)
+		}
+	}
+
+	sort.Slice(reached, func(i, j int) bool {
+		if reached[i].depth != reached[j].depth {
+			return reached[i].depth < reached[j].depth
+		}
+		return reached[i].vertex.GUID < reached[j].vertex.GUID
+	})
+
+	sort.SliceStable(edges, func(i, j int) bool {
+		if edges[i].Source.GUID != edges[j].Source.GUID {
+			return edges[i].Source.GUID < edges[j].Source.GUID
+		}
+		if edges[i].Target.GUID != edges[j].Target.GUID {
+			return edges[i].Target.GUID < edges[j].Target.GUID
+		}
+		return edges[i].Type < edges[j].Type
+	})
+
+	return edges, reached, nil
+}
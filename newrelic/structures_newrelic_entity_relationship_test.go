@@ -0,0 +1,99 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testEntityRelationshipEdge(source string, edgeType string, target string, userDefined bool) entityRelationshipEdge {
+	e := entityRelationshipEdge{
+		Typename: "EntityRelationshipDetectedEdge",
+		Type:     edgeType,
+		Source:   entityRelationshipVertex{GUID: source},
+		Target:   entityRelationshipVertex{GUID: target},
+	}
+	if userDefined {
+		e.Typename = "EntityRelationshipUserDefinedEdge"
+	}
+
+	return e
+}
+
+func TestTraverseEntityRelationships(t *testing.T) {
+	// web calls api, which calls db and cache; host contains api.
+	all := []entityRelationshipEdge{
+		testEntityRelationshipEdge("web", "CALLS", "api", true),
+		testEntityRelationshipEdge("api", "CALLS", "db", false),
+		testEntityRelationshipEdge("api", "CALLS", "cache", true),
+		testEntityRelationshipEdge("host", "CONTAINS", "api", false),
+	}
+
+	fetched := []string{}
+	fetch := func(ctx context.Context, guid string) ([]entityRelationshipEdge, error) {
+		fetched = append(fetched, guid)
+		edges := []entityRelationshipEdge{}
+		for _, e := range all {
+			if e.Source.GUID == guid || e.Target.GUID == guid {
+				edges = append(edges, e)
+			}
+		}
+		return edges, nil
+	}
+
+	guids := func(nodes []entityRelationshipNode) []string {
+		out := []string{}
+		for _, n := range nodes {
+			out = append(out, fmt.Sprintf("%s@%d", n.vertex.GUID, n.depth))
+		}
+		return out
+	}
+
+	edges, nodes, err := traverseEntityRelationships(context.Background(), "web", 1, "both", nil, false, fetch)
+	require.NoError(t, err)
+	require.Len(t, edges, 1)
+	require.Equal(t, []string{"api@1"}, guids(nodes))
+	require.Equal(t, []string{"web"}, fetched)
+
+	_, nodes, err = traverseEntityRelationships(context.Background(), "web", 2, "outgoing", nil, false, fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"api@1", "cache@2", "db@2"}, guids(nodes))
+
+	edges, nodes, err = traverseEntityRelationships(context.Background(), "web", 3, "both", nil, false, fetch)
+	require.NoError(t, err)
+	require.Len(t, edges, 4)
+	require.Equal(t, []string{"api@1", "cache@2", "db@2", "host@2"}, guids(nodes))
+	require.Equal(t, "api", edges[0].Source.GUID)
+
+	_, nodes, err = traverseEntityRelationships(context.Background(), "db", 2, "incoming", nil, false, fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"api@1", "host@2", "web@2"}, guids(nodes))
+
+	_, nodes, err = traverseEntityRelationships(context.Background(), "db", 2, "incoming", []string{"CALLS"}, false, fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"api@1", "web@2"}, guids(nodes))
+
+	_, nodes, err = traverseEntityRelationships(context.Background(), "web", 2, "both", nil, true, fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"api@1", "cache@2"}, guids(nodes))
+
+	_, _, err = traverseEntityRelationships(context.Background(), "web", 2, "both", nil, false, func(ctx context.Context, guid string) ([]entityRelationshipEdge, error) {
+		return nil, fmt.Errorf("boom")
+	})
+	require.Error(t, err)
+}
+
+func TestParseEntityRelationshipID(t *testing.T) {
+	id, err := parseEntityRelationshipID("MXxBUE18QVBQTElDQVRJT058MQ:MXxBUE18QVBQTElDQVRJT058Mg:CALLS")
+	require.NoError(t, err)
+	require.Equal(t, entityRelationshipID{source: "MXxBUE18QVBQTElDQVRJT058MQ", target: "MXxBUE18QVBQTElDQVRJT058Mg", edge: "CALLS"}, id)
+	require.Equal(t, "MXxBUE18QVBQTElDQVRJT058MQ:MXxBUE18QVBQTElDQVRJT058Mg:CALLS", id.String())
+
+	_, err = parseEntityRelationshipID("MXxBUE18QVBQTElDQVRJT058MQ")
+	require.Error(t, err)
+}
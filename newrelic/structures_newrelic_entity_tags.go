@@ -0,0 +1,176 @@
+package newrelic
+
+import (
+	"sort"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+)
+
+// How a newrelic_entity_tags resource owns the tags of its entity.
+const (
+	// Every tag key of the entity is owned: undeclared keys are removed.
+	entityTagsModeAuthoritative = "authoritative"
+	// The declared keys are owned, with exactly the declared values. Other keys are left alone.
+	entityTagsModeKeyAuthoritative = "key_authoritative"
+	// The declared values are added, and never removed.
+	entityTagsModeAdditive = "additive"
+)
+
+func listValidEntityTagsModes() []string {
+	return []string{entityTagsModeAuthoritative, entityTagsModeKeyAuthoritative, entityTagsModeAdditive}
+}
+
+func entityTagValues(tags []*entities.TaggingTagInput) map[string]map[string]bool {
+	values := map[string]map[string]bool{}
+
+	for _, t := range tags {
+		if stringInSlice(defaultTags, t.Key) {
+			continue
+		}
+
+		if values[t.Key] == nil {
+			values[t.Key] = map[string]bool{}
+		}
+		for _, v := range t.Values {
+			values[t.Key][v] = true
+		}
+	}
+
+	return values
+}
+
+func declaredEntityTags(tags []entities.TaggingTagInput) []*entities.TaggingTagInput {
+	out := make([]*entities.TaggingTagInput, 0, len(tags))
+	for i := range tags {
+		out = append(out, &tags[i])
+	}
+
+	return out
+}
+
+// Returns the tags of the entity the resource owns in its mode: every tag in authoritative
+// mode, the declared keys in key authoritative mode, and the declared values which are set
+// in additive mode, so that values removed by someone else are added back.
+func filterEntityTagsForMode(mode string, current []*entities.TaggingTagInput, declared []entities.TaggingTagInput) []*entities.TaggingTagInput {
+	if mode != entityTagsModeKeyAuthoritative && mode != entityTagsModeAdditive {
+		return current
+	}
+
+	declaredValues := entityTagValues(declaredEntityTags(declared))
+	out := []*entities.TaggingTagInput{}
+
+	for _, t := range current {
+		values, ok := declaredValues[t.Key]
+		if !ok {
+			continue
+		}
+
+		if mode == entityTagsModeKeyAuthoritative {
+			out = append(out, t)
+			continue
+		}
+
+		kept := []string{}
+		for _, v := range t.Values {
+			if values[v] {
+				kept = append(kept, v)
+			}
+		}
+		if len(kept) > 0 {
+			out = append(out, &entities.TaggingTagInput{Key: t.Key, Values: kept})
+		}
+	}
+
+	return out
+}
+
+// Returns the keys to delete and the tags to add so that the declared keys have exactly
+// the declared values, in key authoritative mode. Keys which are no longer declared are
+// deleted, other keys are left alone. Tag values cannot be replaced, so a key whose values
+// differ is deleted and added back.
+func planEntityTagsKeyAuthoritative(current []*entities.TaggingTagInput, declared []entities.TaggingTagInput, previous []entities.TaggingTagInput) ([]string, []entities.TaggingTagInput) {
+	currentValues := entityTagValues(current)
+	declaredValues := entityTagValues(declaredEntityTags(declared))
+
+	deleteKeys := []string{}
+	for _, t := range previous {
+		if _, declared := declaredValues[t.Key]; !declared {
+			if _, exists := currentValues[t.Key]; exists {
+				deleteKeys = append(deleteKeys, t.Key)
+			}
+		}
+	}
+
+	add := []entities.TaggingTagInput{}
+	for _, t := range declared {
+		values, exists := currentValues[t.Key]
+		if exists && entityTagValuesEqual(values, declaredValues[t.Key]) {
+			continue
+		}
+
+		if exists {
+			deleteKeys = append(deleteKeys, t.Key)
+		}
+		add = append(add, t)
+	}
+
+	sort.Strings(deleteKeys)
+
+	return deleteKeys, add
+}
+
+func entityTagValuesEqual(a map[string]bool, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for v := range a {
+		if !b[v] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns whether the tags of the entity reflect the declared ones in the mode, as tags
+// are updated asynchronously. The declared values must be set in every mode. In
+// authoritative mode, there must be no other tag, and in key authoritative mode no other
+// value for the declared keys, nor any of the `removedKeys`.
+func entityTagsInSync(mode string, current []*entities.TaggingTagInput, declared []entities.TaggingTagInput, removedKeys []string) bool {
+	currentValues := entityTagValues(current)
+	declaredValues := entityTagValues(declaredEntityTags(declared))
+
+	for key, values := range declaredValues {
+		for v := range values {
+			if !currentValues[key][v] {
+				return false
+			}
+		}
+	}
+
+	switch mode {
+	case entityTagsModeAuthoritative:
+		for key, values := range currentValues {
+			if !entityTagValuesEqual(values, declaredValues[key]) {
+				return false
+			}
+		}
+	case entityTagsModeKeyAuthoritative:
+		for key, values := range declaredValues {
+			if !entityTagValuesEqual(currentValues[key], values) {
+				return false
+			}
+		}
+		for _, key := range removedKeys {
+			if _, ok := declaredValues[key]; ok {
+				continue
+			}
+			if _, ok := currentValues[key]; ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,109 @@
+package newrelic
+
+import (
+	"context"
+	"sort"
+
+	"github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+)
+
+// An entity matching the query of a newrelic_entity_tags_bulk resource, with its tags.
+type entityTagsBulkEntity struct {
+	GUID string
+	Tags []*entities.EntityTag
+}
+
+// Returns all the entities matching the query, sorted by GUID.
+func getEntityTagsBulkEntities(ctx context.Context, client *newrelic.NewRelic, query string) ([]entityTagsBulkEntity, error) {
+	found, err := searchAllEntities(ctx, client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]entityTagsBulkEntity, 0, len(found))
+	for _, e := range found {
+		tags := []*entities.EntityTag{}
+		for _, t := range e.GetTags() {
+			t := t
+			tags = append(tags, &t)
+		}
+
+		matched = append(matched, entityTagsBulkEntity{GUID: string(e.GetGUID()), Tags: tags})
+	}
+
+	sort.Slice(matched, func(i, j int) bool { return matched[i].GUID < matched[j].GUID })
+
+	return matched, nil
+}
+
+// The tags of a newrelic_entity_tags_bulk resource are a map of keys to values.
+func expandEntityTagsBulkTags(tags map[string]interface{}) []entities.TaggingTagInput {
+	out := make([]entities.TaggingTagInput, 0, len(tags))
+	for key, value := range tags {
+		out = append(out, entities.TaggingTagInput{Key: key, Values: []string{value.(string)}})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+
+	return out
+}
+
+// Splits the GUIDs in batches of at most `size` GUIDs, in order.
+func batchEntityTagsBulkGUIDs(guids []string, size int) [][]string {
+	if size < 1 {
+		size = 1
+	}
+
+	batches := [][]string{}
+	for start := 0; start < len(guids); start += size {
+		end := start + size
+		if end > len(guids) {
+			end = len(guids)
+		}
+		batches = append(batches, guids[start:end])
+	}
+
+	return batches
+}
+
+// Splits the matching entities between the ones whose tags have the declared values,
+// and the pending ones, whose tags are yet to be applied.
+func splitEntityTagsBulkEntities(matched []entityTagsBulkEntity, declared []entities.TaggingTagInput) ([]string, []string) {
+	tagged := []string{}
+	pending := []string{}
+
+	for _, e := range matched {
+		if entityTagsInSync(entityTagsModeKeyAuthoritative, convertTagTypes(e.Tags), declared, nil) {
+			tagged = append(tagged, e.GUID)
+		} else {
+			pending = append(pending, e.GUID)
+		}
+	}
+
+	return tagged, pending
+}
+
+// Returns the tracked GUIDs, i.e. the entities tagged by the resource, which no longer
+// match the query, sorted.
+func departedEntityTagsBulkGUIDs(tracked []string, matched []entityTagsBulkEntity) []string {
+	matching := map[string]bool{}
+	for _, e := range matched {
+		matching[e.GUID] = true
+	}
+
+	seen := map[string]bool{}
+	departed := []string{}
+	for _, guid := range tracked {
+		if matching[guid] || seen[guid] {
+			continue
+		}
+
+		seen[guid] = true
+		departed = append(departed, guid)
+	}
+
+	sort.Strings(departed)
+
+	return departed
+}
@@ -0,0 +1,67 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandEntityTagsBulkTags(t *testing.T) {
+	tags := expandEntityTagsBulkTags(map[string]interface{}{
+		"team": "core",
+		"env":  "prod",
+	})
+
+	require.Equal(t, []entities.TaggingTagInput{
+		{Key: "env", Values: []string{"prod"}},
+		{Key: "team", Values: []string{"core"}},
+	}, tags)
+}
+
+func TestBatchEntityTagsBulkGUIDs(t *testing.T) {
+	guids := []string{"a", "b", "c", "d", "e"}
+
+	require.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, batchEntityTagsBulkGUIDs(guids, 2))
+	require.Equal(t, [][]string{guids}, batchEntityTagsBulkGUIDs(guids, 100))
+	require.Len(t, batchEntityTagsBulkGUIDs(guids, 0), 5)
+	require.Empty(t, batchEntityTagsBulkGUIDs(nil, 2))
+}
+
+func TestSplitEntityTagsBulkEntities(t *testing.T) {
+	declared := expandEntityTagsBulkTags(map[string]interface{}{
+		"team": "core",
+		"env":  "prod",
+	})
+
+	matched := []entityTagsBulkEntity{
+		{GUID: "tagged", Tags: []*entities.EntityTag{
+			{Key: "account", Values: []string{"Test"}},
+			{Key: "team", Values: []string{"core"}},
+			{Key: "env", Values: []string{"prod"}},
+			{Key: "owner", Values: []string{"someone"}},
+		}},
+		{GUID: "new", Tags: []*entities.EntityTag{
+			{Key: "account", Values: []string{"Test"}},
+		}},
+		{GUID: "drifted", Tags: []*entities.EntityTag{
+			{Key: "team", Values: []string{"core", "platform"}},
+			{Key: "env", Values: []string{"prod"}},
+		}},
+	}
+
+	tagged, pending := splitEntityTagsBulkEntities(matched, declared)
+	require.Equal(t, []string{"tagged"}, tagged)
+	require.Equal(t, []string{"new", "drifted"}, pending)
+}
+
+func TestDepartedEntityTagsBulkGUIDs(t *testing.T) {
+	matched := []entityTagsBulkEntity{{GUID: "b"}, {GUID: "c"}}
+
+	require.Equal(t, []string{"a", "d"}, departedEntityTagsBulkGUIDs([]string{"d", "b", "a", "c", "d"}, matched))
+	require.Empty(t, departedEntityTagsBulkGUIDs([]string{"b"}, matched))
+	require.Empty(t, departedEntityTagsBulkGUIDs(nil, matched))
+}
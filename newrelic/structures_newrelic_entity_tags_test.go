@@ -0,0 +1,86 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanEntityTagsKeyAuthoritative(t *testing.T) {
+	current := []*entities.TaggingTagInput{
+		{Key: "account", Values: []string{"Test"}},
+		{Key: "team", Values: []string{"core"}},
+		{Key: "env", Values: []string{"prod", "eu"}},
+		{Key: "owner", Values: []string{"someone"}},
+		{Key: "legacy", Values: []string{"yes"}},
+	}
+	previous := []entities.TaggingTagInput{
+		{Key: "team", Values: []string{"core"}},
+		{Key: "env", Values: []string{"prod"}},
+		{Key: "legacy", Values: []string{"yes"}},
+	}
+	declared := []entities.TaggingTagInput{
+		{Key: "team", Values: []string{"core"}},
+		{Key: "env", Values: []string{"prod"}},
+		{Key: "tier", Values: []string{"1"}},
+	}
+
+	deleteKeys, add := planEntityTagsKeyAuthoritative(current, declared, previous)
+	require.Equal(t, []string{"env", "legacy"}, deleteKeys)
+	require.Equal(t, []entities.TaggingTagInput{
+		{Key: "env", Values: []string{"prod"}},
+		{Key: "tier", Values: []string{"1"}},
+	}, add)
+
+	deleteKeys, add = planEntityTagsKeyAuthoritative(current, declared[:1], declared[:1])
+	require.Empty(t, deleteKeys)
+	require.Empty(t, add)
+}
+
+func TestFilterEntityTagsForMode(t *testing.T) {
+	current := []*entities.TaggingTagInput{
+		{Key: "team", Values: []string{"core", "platform"}},
+		{Key: "owner", Values: []string{"someone"}},
+	}
+	declared := []entities.TaggingTagInput{
+		{Key: "team", Values: []string{"core"}},
+		{Key: "env", Values: []string{"prod"}},
+	}
+
+	require.Equal(t, current, filterEntityTagsForMode(entityTagsModeAuthoritative, current, declared))
+	require.Equal(t, current[:1], filterEntityTagsForMode(entityTagsModeKeyAuthoritative, current, declared))
+	require.Equal(t, []*entities.TaggingTagInput{
+		{Key: "team", Values: []string{"core"}},
+	}, filterEntityTagsForMode(entityTagsModeAdditive, current, declared))
+}
+
+func TestEntityTagsInSync(t *testing.T) {
+	declared := []entities.TaggingTagInput{
+		{Key: "team", Values: []string{"core"}},
+	}
+	reserved := &entities.TaggingTagInput{Key: "language", Values: []string{"go"}}
+
+	exact := []*entities.TaggingTagInput{reserved, {Key: "team", Values: []string{"core"}}}
+	extraKey := []*entities.TaggingTagInput{reserved, {Key: "team", Values: []string{"core"}}, {Key: "owner", Values: []string{"someone"}}}
+	extraValue := []*entities.TaggingTagInput{reserved, {Key: "team", Values: []string{"core", "platform"}}}
+	missing := []*entities.TaggingTagInput{reserved}
+
+	for _, mode := range listValidEntityTagsModes() {
+		require.True(t, entityTagsInSync(mode, exact, declared, nil), mode)
+		require.False(t, entityTagsInSync(mode, missing, declared, nil), mode)
+	}
+
+	require.False(t, entityTagsInSync(entityTagsModeAuthoritative, extraKey, declared, nil))
+	require.False(t, entityTagsInSync(entityTagsModeAuthoritative, extraValue, declared, nil))
+
+	require.True(t, entityTagsInSync(entityTagsModeKeyAuthoritative, extraKey, declared, nil))
+	require.False(t, entityTagsInSync(entityTagsModeKeyAuthoritative, extraKey, declared, []string{"owner"}))
+	require.False(t, entityTagsInSync(entityTagsModeKeyAuthoritative, extraValue, declared, []string{"team"}))
+
+	require.True(t, entityTagsInSync(entityTagsModeAdditive, extraKey, declared, nil))
+	require.True(t, entityTagsInSync(entityTagsModeAdditive, extraValue, declared, nil))
+}
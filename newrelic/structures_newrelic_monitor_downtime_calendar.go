@@ -0,0 +1,458 @@
+package newrelic
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const monitorDowntimeCalendarNaiveDateTimeFormat = "2006-01-02T15:04:05"
+
+var monitorDowntimeCalendarScheduleTypes = struct {
+	downtime   string
+	mutingRule string
+}{
+	downtime:   "downtime",
+	mutingRule: "muting_rule",
+}
+
+var monitorDowntimeCalendarWeekDays = map[string]time.Weekday{
+	"SUNDAY":    time.Sunday,
+	"MONDAY":    time.Monday,
+	"TUESDAY":   time.Tuesday,
+	"WEDNESDAY": time.Wednesday,
+	"THURSDAY":  time.Thursday,
+	"FRIDAY":    time.Friday,
+	"SATURDAY":  time.Saturday,
+}
+
+// monitorDowntimeCalendarSchedule is the common representation of a monitor downtime
+// and of a muting rule schedule, from which concrete windows are generated.
+type monitorDowntimeCalendarSchedule struct {
+	Type         string
+	Name         string
+	MonitorGUIDs []string
+	Mode         string
+	Location     *time.Location
+	// Start and End are the first occurrence of the schedule, as wall clock times in Location.
+	Start time.Time
+	End   time.Time
+	// WeekDays is used with the WEEKLY mode.
+	WeekDays []time.Weekday
+	// DaysOfMonth, or Ordinal and OrdinalWeekDay, are used with the MONTHLY mode.
+	DaysOfMonth    []int
+	Ordinal        string
+	OrdinalWeekDay time.Weekday
+	// RepeatUntil, when set, is the exclusive upper bound of the start of an occurrence.
+	RepeatUntil time.Time
+	// RepeatCount, when set, is the total number of occurrences of the schedule.
+	RepeatCount int
+}
+
+type monitorDowntimeCalendarWindow struct {
+	Schedule int
+	Start    time.Time
+	End      time.Time
+}
+
+type monitorDowntimeCalendarOverlap struct {
+	MonitorGUID string
+	First       int
+	Second      int
+	Start       time.Time
+	End         time.Time
+}
+
+func parseMonitorDowntimeCalendarNaiveDateTime(value string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(monitorDowntimeCalendarNaiveDateTimeFormat, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%#v must be in the format %s", value, monitorDowntimeCalendarNaiveDateTimeFormat)
+	}
+
+	return t, nil
+}
+
+func expandMonitorDowntimeCalendarDowntime(cfg map[string]interface{}) (*monitorDowntimeCalendarSchedule, error) {
+	name := cfg["name"].(string)
+	mode := cfg["mode"].(string)
+
+	loc, err := time.LoadLocation(cfg["time_zone"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("downtime %q: %w", name, err)
+	}
+
+	schedule := &monitorDowntimeCalendarSchedule{
+		Type:     monitorDowntimeCalendarScheduleTypes.downtime,
+		Name:     name,
+		Mode:     mode,
+		Location: loc,
+	}
+
+	if err := expandMonitorDowntimeCalendarStartEnd(schedule, cfg["start_time"].(string), cfg["end_time"].(string)); err != nil {
+		return nil, fmt.Errorf("downtime %q: %w", name, err)
+	}
+
+	if v, ok := cfg["monitor_guids"]; ok {
+		schedule.MonitorGUIDs = expandStringSlice(v.(*schema.Set).List())
+	}
+
+	maintenanceDays := cfg["maintenance_days"].(*schema.Set).List()
+	frequency := cfg["frequency"].([]interface{})
+	endRepeat := cfg["end_repeat"].([]interface{})
+
+	if mode == SyntheticsMonitorDowntimeModes.WEEKLY && len(maintenanceDays) == 0 {
+		return nil, fmt.Errorf("downtime %q: `maintenance_days` is required with the mode `WEEKLY`", name)
+	}
+	if mode != SyntheticsMonitorDowntimeModes.WEEKLY && len(maintenanceDays) > 0 {
+		return nil, fmt.Errorf("downtime %q: `maintenance_days` may only be used with the mode `WEEKLY`", name)
+	}
+	if mode == SyntheticsMonitorDowntimeModes.MONTHLY && len(frequency) == 0 {
+		return nil, fmt.Errorf("downtime %q: `frequency` is required with the mode `MONTHLY`", name)
+	}
+	if mode != SyntheticsMonitorDowntimeModes.MONTHLY && len(frequency) > 0 {
+		return nil, fmt.Errorf("downtime %q: `frequency` may only be used with the mode `MONTHLY`", name)
+	}
+	if mode == SyntheticsMonitorDowntimeModes.OneTime && len(endRepeat) > 0 {
+		return nil, fmt.Errorf("downtime %q: `end_repeat` may only be used with the modes `DAILY`, `MONTHLY` and `WEEKLY`", name)
+	}
+
+	for _, day := range maintenanceDays {
+		schedule.WeekDays = append(schedule.WeekDays, monitorDowntimeCalendarWeekDays[day.(string)])
+	}
+
+	if len(frequency) > 0 && frequency[0] != nil {
+		f := frequency[0].(map[string]interface{})
+
+		if v, ok := f["days_of_month"]; ok {
+			for _, day := range v.(*schema.Set).List() {
+				schedule.DaysOfMonth = append(schedule.DaysOfMonth, day.(int))
+			}
+		}
+
+		if v, ok := f["days_of_week"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			daysOfWeek := v.([]interface{})[0].(map[string]interface{})
+			schedule.Ordinal = daysOfWeek["ordinal_day_of_month"].(string)
+			schedule.OrdinalWeekDay = monitorDowntimeCalendarWeekDays[daysOfWeek["week_day"].(string)]
+		}
+
+		if len(schedule.DaysOfMonth) == 0 && schedule.Ordinal == "" {
+			return nil, fmt.Errorf("downtime %q: exactly one of `days_of_month` or `days_of_week` is required in `frequency`", name)
+		}
+	}
+
+	if len(endRepeat) > 0 && endRepeat[0] != nil {
+		e := endRepeat[0].(map[string]interface{})
+
+		if (e["on_date"].(string) == "") == (e["on_repeat"].(int) == 0) {
+			return nil, fmt.Errorf("downtime %q: exactly one of `on_date` or `on_repeat` is required in `end_repeat`", name)
+		}
+
+		if onDate := e["on_date"].(string); onDate != "" {
+			date, err := time.ParseInLocation("2006-01-02", onDate, loc)
+			if err != nil {
+				return nil, fmt.Errorf("downtime %q: invalid `on_date` %s", name, onDate)
+			}
+			// occurrences starting on `on_date` are still part of the schedule
+			schedule.RepeatUntil = date.AddDate(0, 0, 1)
+		}
+
+		schedule.RepeatCount = e["on_repeat"].(int)
+	}
+
+	return schedule, nil
+}
+
+func expandMonitorDowntimeCalendarMutingRule(cfg map[string]interface{}) (*monitorDowntimeCalendarSchedule, error) {
+	name := cfg["name"].(string)
+
+	loc, err := time.LoadLocation(cfg["time_zone"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("muting rule %q: %w", name, err)
+	}
+
+	schedule := &monitorDowntimeCalendarSchedule{
+		Type:     monitorDowntimeCalendarScheduleTypes.mutingRule,
+		Name:     name,
+		Mode:     SyntheticsMonitorDowntimeModes.OneTime,
+		Location: loc,
+	}
+
+	if err := expandMonitorDowntimeCalendarStartEnd(schedule, cfg["start_time"].(string), cfg["end_time"].(string)); err != nil {
+		return nil, fmt.Errorf("muting rule %q: %w", name, err)
+	}
+
+	if v, ok := cfg["monitor_guids"]; ok {
+		schedule.MonitorGUIDs = expandStringSlice(v.(*schema.Set).List())
+	}
+
+	weeklyRepeatDays := cfg["weekly_repeat_days"].(*schema.Set).List()
+
+	repeat := cfg["repeat"].(string)
+
+	switch repeat {
+	case "":
+		if cfg["end_repeat"].(string) != "" || cfg["repeat_count"].(int) > 0 {
+			return nil, fmt.Errorf("muting rule %q: `end_repeat` and `repeat_count` may only be used with `repeat`", name)
+		}
+	case "WEEKLY":
+		schedule.Mode = SyntheticsMonitorDowntimeModes.WEEKLY
+		for _, day := range weeklyRepeatDays {
+			schedule.WeekDays = append(schedule.WeekDays, monitorDowntimeCalendarWeekDays[day.(string)])
+		}
+		if len(schedule.WeekDays) == 0 {
+			schedule.WeekDays = []time.Weekday{schedule.Start.Weekday()}
+		}
+	case "MONTHLY":
+		schedule.Mode = SyntheticsMonitorDowntimeModes.MONTHLY
+		schedule.DaysOfMonth = []int{schedule.Start.Day()}
+	default:
+		schedule.Mode = repeat
+	}
+
+	if repeat != "WEEKLY" && len(weeklyRepeatDays) > 0 {
+		return nil, fmt.Errorf("muting rule %q: `weekly_repeat_days` may only be used when `repeat` is `WEEKLY`", name)
+	}
+
+	if v := cfg["end_repeat"].(string); v != "" {
+		schedule.RepeatUntil, err = parseMonitorDowntimeCalendarNaiveDateTime(v, loc)
+		if err != nil {
+			return nil, fmt.Errorf("muting rule %q: invalid `end_repeat`: %w", name, err)
+		}
+		// a muting rule keeps repeating up to and including `end_repeat`
+		schedule.RepeatUntil = schedule.RepeatUntil.Add(time.Second)
+	}
+
+	schedule.RepeatCount = cfg["repeat_count"].(int)
+
+	return schedule, nil
+}
+
+func expandMonitorDowntimeCalendarStartEnd(schedule *monitorDowntimeCalendarSchedule, startTime string, endTime string) error {
+	start, err := parseMonitorDowntimeCalendarNaiveDateTime(startTime, schedule.Location)
+	if err != nil {
+		return fmt.Errorf("invalid `start_time`: %w", err)
+	}
+
+	end, err := parseMonitorDowntimeCalendarNaiveDateTime(endTime, schedule.Location)
+	if err != nil {
+		return fmt.Errorf("invalid `end_time`: %w", err)
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("`end_time` %s must be after `start_time` %s", endTime, startTime)
+	}
+
+	schedule.Start = start
+	schedule.End = end
+
+	return nil
+}
+
+// Reports whether a recurring schedule has an occurrence on the given calendar date.
+func (s *monitorDowntimeCalendarSchedule) occursOn(year int, month time.Month, day int) bool {
+	weekDay := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()
+
+	switch s.Mode {
+	case SyntheticsMonitorDowntimeModes.DAILY:
+		return true
+	case SyntheticsMonitorDowntimeModes.WEEKLY:
+		for _, d := range s.WeekDays {
+			if d == weekDay {
+				return true
+			}
+		}
+	case SyntheticsMonitorDowntimeModes.MONTHLY:
+		if s.Ordinal != "" {
+			if weekDay != s.OrdinalWeekDay {
+				return false
+			}
+
+			switch s.Ordinal {
+			case "FIRST":
+				return day <= 7
+			case "SECOND":
+				return day > 7 && day <= 14
+			case "THIRD":
+				return day > 14 && day <= 21
+			case "FOURTH":
+				return day > 21 && day <= 28
+			case "LAST":
+				return day+7 > daysInMonth(year, month)
+			}
+
+			return false
+		}
+
+		// days which do not exist in a month, such as the 31st of April, are skipped
+		for _, d := range s.DaysOfMonth {
+			if d == day {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Expands a schedule into the concrete windows overlapping [from, until). Occurrences
+// keep the wall clock times of `start_time` and `end_time` in the schedule's time zone,
+// so windows shift in UTC across daylight saving time changes, like New Relic does.
+// Occurrences before `from` still count towards `RepeatCount`.
+func expandMonitorDowntimeCalendarWindows(s *monitorDowntimeCalendarSchedule, index int, from time.Time, until time.Time) []monitorDowntimeCalendarWindow {
+	windows := []monitorDowntimeCalendarWindow{}
+
+	if s.Mode == SyntheticsMonitorDowntimeModes.OneTime {
+		if s.End.After(from) && s.Start.Before(until) {
+			windows = append(windows, monitorDowntimeCalendarWindow{Schedule: index, Start: s.Start.UTC(), End: s.End.UTC()})
+		}
+
+		return windows
+	}
+
+	// the number of days an occurrence spans, e.g. 1 for a window from 22:00 to 02:00
+	startDate := time.Date(s.Start.Year(), s.Start.Month(), s.Start.Day(), 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(s.End.Year(), s.End.Month(), s.End.Day(), 0, 0, 0, 0, time.UTC)
+	spanDays := int(endDate.Sub(startDate).Hours() / 24)
+
+	count := 0
+	for date := startDate; ; date = date.AddDate(0, 0, 1) {
+		year, month, day := date.Date()
+
+		start := time.Date(year, month, day, s.Start.Hour(), s.Start.Minute(), s.Start.Second(), 0, s.Location)
+		if !start.Before(until) {
+			break
+		}
+		if !s.RepeatUntil.IsZero() && !start.Before(s.RepeatUntil) {
+			break
+		}
+		if s.RepeatCount > 0 && count >= s.RepeatCount {
+			break
+		}
+
+		if !s.occursOn(year, month, day) {
+			continue
+		}
+
+		count++
+
+		end := time.Date(year, month, day+spanDays, s.End.Hour(), s.End.Minute(), s.End.Second(), 0, s.Location)
+		if end.After(from) {
+			windows = append(windows, monitorDowntimeCalendarWindow{Schedule: index, Start: start.UTC(), End: end.UTC()})
+		}
+	}
+
+	return windows
+}
+
+// Finds, for every monitor, the windows of two different schedules covering it at the same time.
+func findMonitorDowntimeCalendarOverlaps(schedules []*monitorDowntimeCalendarSchedule, windows []monitorDowntimeCalendarWindow) []monitorDowntimeCalendarOverlap {
+	windowsByMonitor := map[string][]monitorDowntimeCalendarWindow{}
+
+	for _, w := range windows {
+		for _, guid := range schedules[w.Schedule].MonitorGUIDs {
+			windowsByMonitor[guid] = append(windowsByMonitor[guid], w)
+		}
+	}
+
+	overlaps := []monitorDowntimeCalendarOverlap{}
+
+	for guid, monitorWindows := range windowsByMonitor {
+		for i := 0; i < len(monitorWindows); i++ {
+			for j := i + 1; j < len(monitorWindows); j++ {
+				a, b := monitorWindows[i], monitorWindows[j]
+				if a.Schedule == b.Schedule {
+					continue
+				}
+
+				start, end := a.Start, a.End
+				if b.Start.After(start) {
+					start = b.Start
+				}
+				if b.End.Before(end) {
+					end = b.End
+				}
+				if !start.Before(end) {
+					continue
+				}
+
+				first, second := a.Schedule, b.Schedule
+				if first > second {
+					first, second = second, first
+				}
+
+				overlaps = append(overlaps, monitorDowntimeCalendarOverlap{
+					MonitorGUID: guid,
+					First:       first,
+					Second:      second,
+					Start:       start,
+					End:         end,
+				})
+			}
+		}
+	}
+
+	sort.Slice(overlaps, func(i, j int) bool {
+		if !overlaps[i].Start.Equal(overlaps[j].Start) {
+			return overlaps[i].Start.Before(overlaps[j].Start)
+		}
+		if overlaps[i].MonitorGUID != overlaps[j].MonitorGUID {
+			return overlaps[i].MonitorGUID < overlaps[j].MonitorGUID
+		}
+		if overlaps[i].First != overlaps[j].First {
+			return overlaps[i].First < overlaps[j].First
+		}
+		return overlaps[i].Second < overlaps[j].Second
+	})
+
+	return overlaps
+}
+
+func flattenMonitorDowntimeCalendarWindows(schedules []*monitorDowntimeCalendarSchedule, windows []monitorDowntimeCalendarWindow) []interface{} {
+	out := make([]interface{}, 0, len(windows))
+
+	for _, w := range windows {
+		s := schedules[w.Schedule]
+		out = append(out, map[string]interface{}{
+			"type":          s.Type,
+			"name":          s.Name,
+			"start":         w.Start.Format(time.RFC3339),
+			"end":           w.End.Format(time.RFC3339),
+			"monitor_guids": s.MonitorGUIDs,
+		})
+	}
+
+	return out
+}
+
+func flattenMonitorDowntimeCalendarOverlaps(schedules []*monitorDowntimeCalendarSchedule, overlaps []monitorDowntimeCalendarOverlap) ([]interface{}, []string) {
+	out := make([]interface{}, 0, len(overlaps))
+	guids := map[string]bool{}
+
+	for _, o := range overlaps {
+		first, second := schedules[o.First], schedules[o.Second]
+		out = append(out, map[string]interface{}{
+			"monitor_guid": o.MonitorGUID,
+			"first_type":   first.Type,
+			"first_name":   first.Name,
+			"second_type":  second.Type,
+			"second_name":  second.Name,
+			"start":        o.Start.Format(time.RFC3339),
+			"end":          o.End.Format(time.RFC3339),
+		})
+		guids[o.MonitorGUID] = true
+	}
+
+	monitorGUIDs := make([]string, 0, len(guids))
+	for guid := range guids {
+		monitorGUIDs = append(monitorGUIDs, guid)
+	}
+	sort.Strings(monitorGUIDs)
+
+	return out, monitorGUIDs
+}
@@ -0,0 +1,255 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testExpandMonitorDowntimeCalendarSchedules(t *testing.T, downtimes []interface{}, mutingRules []interface{}) []*monitorDowntimeCalendarSchedule {
+	d := dataSourceNewRelicMonitorDowntimeCalendar().TestResourceData()
+	require.NoError(t, d.Set("downtime", downtimes))
+	require.NoError(t, d.Set("muting_rule", mutingRules))
+
+	schedules := []*monitorDowntimeCalendarSchedule{}
+
+	for _, v := range d.Get("downtime").([]interface{}) {
+		s, err := expandMonitorDowntimeCalendarDowntime(v.(map[string]interface{}))
+		require.NoError(t, err)
+		schedules = append(schedules, s)
+	}
+
+	for _, v := range d.Get("muting_rule").([]interface{}) {
+		s, err := expandMonitorDowntimeCalendarMutingRule(v.(map[string]interface{}))
+		require.NoError(t, err)
+		schedules = append(schedules, s)
+	}
+
+	return schedules
+}
+
+func testMonitorDowntimeCalendarWindowStrings(windows []monitorDowntimeCalendarWindow) []string {
+	out := []string{}
+	for _, w := range windows {
+		out = append(out, w.Start.Format(time.RFC3339)+"/"+w.End.Format(time.RFC3339))
+	}
+	return out
+}
+
+func TestExpandMonitorDowntimeCalendarWindows(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		Downtime map[string]interface{}
+		From     time.Time
+		Days     int
+		Expected []string
+	}{
+		"one time": {
+			Downtime: map[string]interface{}{
+				"name":       "one time",
+				"mode":       "ONE_TIME",
+				"start_time": "2026-01-10T10:00:00",
+				"end_time":   "2026-01-10T12:00:00",
+				"time_zone":  "Asia/Kolkata",
+			},
+			From:     from,
+			Days:     30,
+			Expected: []string{"2026-01-10T04:30:00Z/2026-01-10T06:30:00Z"},
+		},
+		"daily across daylight saving time": {
+			Downtime: map[string]interface{}{
+				"name":       "daily",
+				"mode":       "DAILY",
+				"start_time": "2026-03-07T01:00:00",
+				"end_time":   "2026-03-07T03:00:00",
+				"time_zone":  "America/New_York",
+			},
+			From: time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),
+			Days: 3,
+			Expected: []string{
+				"2026-03-07T06:00:00Z/2026-03-07T08:00:00Z",
+				"2026-03-08T06:00:00Z/2026-03-08T07:00:00Z",
+				"2026-03-09T05:00:00Z/2026-03-09T07:00:00Z",
+			},
+		},
+		"daily limited by on_repeat before from": {
+			Downtime: map[string]interface{}{
+				"name":       "daily",
+				"mode":       "DAILY",
+				"start_time": "2026-01-01T10:00:00",
+				"end_time":   "2026-01-01T11:00:00",
+				"time_zone":  "UTC",
+				"end_repeat": []interface{}{map[string]interface{}{"on_repeat": 5}},
+			},
+			From: time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+			Days: 30,
+			Expected: []string{
+				"2026-01-03T10:00:00Z/2026-01-03T11:00:00Z",
+				"2026-01-04T10:00:00Z/2026-01-04T11:00:00Z",
+				"2026-01-05T10:00:00Z/2026-01-05T11:00:00Z",
+			},
+		},
+		"weekly limited by on_date": {
+			Downtime: map[string]interface{}{
+				"name":             "weekly",
+				"mode":             "WEEKLY",
+				"start_time":       "2026-01-01T23:00:00",
+				"end_time":         "2026-01-02T01:00:00",
+				"time_zone":        "UTC",
+				"maintenance_days": []interface{}{"MONDAY"},
+				"end_repeat":       []interface{}{map[string]interface{}{"on_date": "2026-01-12"}},
+			},
+			From: from,
+			Days: 60,
+			Expected: []string{
+				"2026-01-05T23:00:00Z/2026-01-06T01:00:00Z",
+				"2026-01-12T23:00:00Z/2026-01-13T01:00:00Z",
+			},
+		},
+		"monthly on the last friday": {
+			Downtime: map[string]interface{}{
+				"name":       "monthly",
+				"mode":       "MONTHLY",
+				"start_time": "2026-01-01T22:00:00",
+				"end_time":   "2026-01-02T02:00:00",
+				"time_zone":  "UTC",
+				"frequency": []interface{}{map[string]interface{}{
+					"days_of_week": []interface{}{map[string]interface{}{
+						"ordinal_day_of_month": "LAST",
+						"week_day":             "FRIDAY",
+					}},
+				}},
+			},
+			From: from,
+			Days: 59,
+			Expected: []string{
+				"2026-01-30T22:00:00Z/2026-01-31T02:00:00Z",
+				"2026-02-27T22:00:00Z/2026-02-28T02:00:00Z",
+			},
+		},
+		"monthly skips missing days of month": {
+			Downtime: map[string]interface{}{
+				"name":       "monthly",
+				"mode":       "MONTHLY",
+				"start_time": "2026-01-01T08:00:00",
+				"end_time":   "2026-01-01T09:00:00",
+				"time_zone":  "UTC",
+				"frequency": []interface{}{map[string]interface{}{
+					"days_of_month": []interface{}{31},
+				}},
+			},
+			From: from,
+			Days: 120,
+			Expected: []string{
+				"2026-01-31T08:00:00Z/2026-01-31T09:00:00Z",
+				"2026-03-31T08:00:00Z/2026-03-31T09:00:00Z",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			schedules := testExpandMonitorDowntimeCalendarSchedules(t, []interface{}{tc.Downtime}, nil)
+			windows := expandMonitorDowntimeCalendarWindows(schedules[0], 0, tc.From, tc.From.AddDate(0, 0, tc.Days))
+			require.Equal(t, tc.Expected, testMonitorDowntimeCalendarWindowStrings(windows))
+		})
+	}
+}
+
+func TestExpandMonitorDowntimeCalendarMutingRuleWindows(t *testing.T) {
+	schedules := testExpandMonitorDowntimeCalendarSchedules(t, nil, []interface{}{
+		map[string]interface{}{
+			"name":         "release",
+			"start_time":   "2026-01-15T09:00:00",
+			"end_time":     "2026-01-15T10:00:00",
+			"time_zone":    "Europe/Berlin",
+			"repeat":       "MONTHLY",
+			"repeat_count": 2,
+		},
+	})
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	windows := expandMonitorDowntimeCalendarWindows(schedules[0], 0, from, from.AddDate(0, 0, 120))
+
+	require.Equal(t, []string{
+		"2026-01-15T08:00:00Z/2026-01-15T09:00:00Z",
+		"2026-02-15T08:00:00Z/2026-02-15T09:00:00Z",
+	}, testMonitorDowntimeCalendarWindowStrings(windows))
+}
+
+func TestExpandMonitorDowntimeCalendarDowntime_Invalid(t *testing.T) {
+	d := dataSourceNewRelicMonitorDowntimeCalendar().TestResourceData()
+	require.NoError(t, d.Set("downtime", []interface{}{
+		map[string]interface{}{
+			"name":       "weekly",
+			"mode":       "WEEKLY",
+			"start_time": "2026-01-01T10:00:00",
+			"end_time":   "2026-01-01T11:00:00",
+			"time_zone":  "UTC",
+		},
+		map[string]interface{}{
+			"name":       "backwards",
+			"mode":       "ONE_TIME",
+			"start_time": "2026-01-01T10:00:00",
+			"end_time":   "2026-01-01T09:00:00",
+			"time_zone":  "UTC",
+		},
+	}))
+
+	for _, v := range d.Get("downtime").([]interface{}) {
+		_, err := expandMonitorDowntimeCalendarDowntime(v.(map[string]interface{}))
+		require.Error(t, err)
+	}
+}
+
+func TestFindMonitorDowntimeCalendarOverlaps(t *testing.T) {
+	schedules := testExpandMonitorDowntimeCalendarSchedules(t,
+		[]interface{}{
+			map[string]interface{}{
+				"name":          "nightly",
+				"mode":          "DAILY",
+				"start_time":    "2026-01-01T22:00:00",
+				"end_time":      "2026-01-02T02:00:00",
+				"time_zone":     "UTC",
+				"monitor_guids": []interface{}{"monitor-a", "monitor-b"},
+			},
+		},
+		[]interface{}{
+			map[string]interface{}{
+				"name":          "patching",
+				"start_time":    "2026-01-02T01:00:00",
+				"end_time":      "2026-01-02T03:00:00",
+				"time_zone":     "UTC",
+				"monitor_guids": []interface{}{"monitor-b", "monitor-c"},
+			},
+		},
+	)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 0, 3)
+
+	windows := []monitorDowntimeCalendarWindow{}
+	for i, s := range schedules {
+		windows = append(windows, expandMonitorDowntimeCalendarWindows(s, i, from, until)...)
+	}
+
+	overlaps, guids := flattenMonitorDowntimeCalendarOverlaps(schedules, findMonitorDowntimeCalendarOverlaps(schedules, windows))
+
+	require.Equal(t, []string{"monitor-b"}, guids)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"monitor_guid": "monitor-b",
+			"first_type":   "downtime",
+			"first_name":   "nightly",
+			"second_type":  "muting_rule",
+			"second_name":  "patching",
+			"start":        "2026-01-02T01:00:00Z",
+			"end":          "2026-01-02T02:00:00Z",
+		},
+	}, overlaps)
+}
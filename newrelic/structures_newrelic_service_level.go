@@ -2,6 +2,7 @@ package newrelic
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/servicelevel"
@@ -357,3 +358,55 @@ func expandServiceLevelObjectiveRollingTimeWindowUpdateInput(cfg map[string]inte
 
 	return rolling
 }
+
+// Rejects the targets refused by the API: between 0 and 100 excluded, with up to 5 decimals.
+func validateServiceLevelObjectiveTarget(v interface{}, k string) ([]string, []error) {
+	target := v.(float64)
+
+	if target <= 0 || target >= 100 {
+		return nil, []error{fmt.Errorf("expected %s to be between 0 and 100 excluded, got %v", k, target)}
+	}
+
+	if scaled := target * 1e5; math.Abs(scaled-math.Round(scaled)) > 1e-6 {
+		return nil, []error{fmt.Errorf("expected %s to have up to 5 decimals, got %v", k, target)}
+	}
+
+	return nil, nil
+}
+
+// Returns an error naming the first two objectives which use the same time window.
+func validateServiceLevelObjectives(objectives []interface{}) error {
+	windows := map[string]string{}
+
+	for i, o := range objectives {
+		objective := o.(map[string]interface{})
+
+		name := fmt.Sprintf("#%d", i+1)
+		if n, ok := objective["name"].(string); ok && n != "" {
+			name = fmt.Sprintf("%q", n)
+		}
+
+		window := describeServiceLevelTimeWindow(objective["time_window"].([]interface{}))
+		if other, ok := windows[window]; ok {
+			return fmt.Errorf("objectives %s and %s conflict as they both use a %s time window, only one objective can be defined", other, name, window)
+		}
+		windows[window] = name
+	}
+
+	return nil
+}
+
+func describeServiceLevelTimeWindow(timeWindow []interface{}) string {
+	if len(timeWindow) == 0 || timeWindow[0] == nil {
+		return "unknown"
+	}
+
+	rolling, _ := timeWindow[0].(map[string]interface{})["rolling"].([]interface{})
+	if len(rolling) == 0 || rolling[0] == nil {
+		return "unknown"
+	}
+
+	r := rolling[0].(map[string]interface{})
+
+	return fmt.Sprintf("rolling %d %s", r["count"].(int), r["unit"].(string))
+}
@@ -0,0 +1,242 @@
+package newrelic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+)
+
+// A burn rate alert, raised when the error budget of a service level is consumed faster
+// than `toleratedBudgetConsumption` percent per `aggregationWindow`. A single condition is
+// created for the window, which slides by `slideBy`. This is not the multi-window alerting
+// of the Google SRE workbook: NRQL conditions evaluate one aggregation window, and cannot
+// require the burn rate over a shorter window to exceed the threshold as well.
+type serviceLevelAlertWindow struct {
+	severity                   string
+	aggregationWindow          int
+	slideBy                    int
+	toleratedBudgetConsumption float64
+}
+
+// The burn rates recommended by the Google SRE workbook whose window fits in the longest
+// aggregation window of a NRQL condition, the same as the `fast_burn` and `slow_burn`
+// alert types of the newrelic_service_level_alert_helper data source.
+var serviceLevelAlertDefaultWindows = []serviceLevelAlertWindow{
+	{severity: "critical", aggregationWindow: 3600, slideBy: 300, toleratedBudgetConsumption: 2},
+	{severity: "warning", aggregationWindow: 21600, slideBy: 1800, toleratedBudgetConsumption: 5},
+}
+
+// A NRQL condition of a newrelic_service_level_alert.
+type serviceLevelAlertCondition struct {
+	severity    string
+	window      int
+	slideBy     int
+	threshold   float64
+	conditionID string
+}
+
+func (c serviceLevelAlertCondition) key() string {
+	return fmt.Sprintf("%s:%d", c.severity, c.window)
+}
+
+func expandServiceLevelAlertWindows(cfg []interface{}) []serviceLevelAlertWindow {
+	if len(cfg) == 0 {
+		return serviceLevelAlertDefaultWindows
+	}
+
+	windows := make([]serviceLevelAlertWindow, 0, len(cfg))
+	for _, w := range cfg {
+		window := w.(map[string]interface{})
+		windows = append(windows, serviceLevelAlertWindow{
+			severity:                   window["severity"].(string),
+			aggregationWindow:          window["aggregation_window"].(int),
+			slideBy:                    window["slide_by"].(int),
+			toleratedBudgetConsumption: window["tolerated_budget_consumption"].(float64),
+		})
+	}
+
+	return windows
+}
+
+func validateServiceLevelAlertWindows(windows []serviceLevelAlertWindow) error {
+	seen := map[string]bool{}
+
+	for _, w := range windows {
+		// Windows not known until apply are validated then.
+		if w.slideBy == 0 || w.aggregationWindow == 0 {
+			continue
+		}
+
+		if w.slideBy >= w.aggregationWindow {
+			return fmt.Errorf("the slide_by interval (%d seconds) of a %s window must be shorter than its aggregation window (%d seconds)", w.slideBy, w.severity, w.aggregationWindow)
+		}
+
+		if w.aggregationWindow%w.slideBy != 0 {
+			return fmt.Errorf("the aggregation window (%d seconds) of a %s window must be a multiple of its slide_by interval (%d seconds)", w.aggregationWindow, w.severity, w.slideBy)
+		}
+
+		key := fmt.Sprintf("%s:%d", w.severity, w.aggregationWindow)
+		if seen[key] {
+			return fmt.Errorf("more than one %s condition would evaluate a %d seconds window", w.severity, w.aggregationWindow)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Returns the conditions of the windows, one per window, ordered by severity and window.
+func serviceLevelAlertConditions(windows []serviceLevelAlertWindow, sloTarget float64, sloPeriod int) []serviceLevelAlertCondition {
+	conditions := []serviceLevelAlertCondition{}
+
+	for _, w := range windows {
+		conditions = append(conditions, serviceLevelAlertCondition{
+			severity:  w.severity,
+			window:    w.aggregationWindow,
+			slideBy:   w.slideBy,
+			threshold: calculateThreshold(sloTarget, w.toleratedBudgetConsumption, sloPeriod, w.aggregationWindow),
+		})
+	}
+
+	sort.SliceStable(conditions, func(i, j int) bool {
+		if conditions[i].severity != conditions[j].severity {
+			return conditions[i].severity < conditions[j].severity
+		}
+		return conditions[i].window < conditions[j].window
+	})
+
+	return conditions
+}
+
+func flattenServiceLevelAlertConditions(conditions []serviceLevelAlertCondition) []interface{} {
+	out := make([]interface{}, 0, len(conditions))
+
+	for _, c := range conditions {
+		out = append(out, map[string]interface{}{
+			"severity":     c.severity,
+			"window":       c.window,
+			"threshold":    c.threshold,
+			"condition_id": c.conditionID,
+		})
+	}
+
+	return out
+}
+
+func expandServiceLevelAlertConditions(cfg []interface{}) []serviceLevelAlertCondition {
+	conditions := make([]serviceLevelAlertCondition, 0, len(cfg))
+
+	for _, c := range cfg {
+		condition := c.(map[string]interface{})
+		conditions = append(conditions, serviceLevelAlertCondition{
+			severity:    condition["severity"].(string),
+			window:      condition["window"].(int),
+			threshold:   condition["threshold"].(float64),
+			conditionID: condition["condition_id"].(string),
+		})
+	}
+
+	return conditions
+}
+
+// Returns whether the conditions in state match the desired ones, regardless of their IDs.
+// Thresholds are compared with a tolerance, as they are rounded by the API.
+func serviceLevelAlertConditionsInSync(current []serviceLevelAlertCondition, desired []serviceLevelAlertCondition) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	thresholds := map[string]float64{}
+	for _, c := range current {
+		thresholds[c.key()] = c.threshold
+	}
+
+	for _, c := range desired {
+		threshold, ok := thresholds[c.key()]
+		if !ok || math.Abs(threshold-c.threshold) > 1e-6 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Formats a window in the largest unit it is a multiple of, e.g. `1h` or `5m`.
+func formatServiceLevelAlertWindow(seconds int) string {
+	switch {
+	case seconds%3600 == 0:
+		return fmt.Sprintf("%dh", seconds/3600)
+	case seconds%60 == 0:
+		return fmt.Sprintf("%dm", seconds/60)
+	}
+
+	return fmt.Sprintf("%ds", seconds)
+}
+
+// Returns the settings of a condition, in the create input which the update input is built from.
+func expandServiceLevelAlertConditionInput(c serviceLevelAlertCondition, name string, nrql string, enabled bool, runbookURL string) alerts.NrqlConditionCreateInput {
+	threshold := c.threshold
+	window := c.window
+	aggregationDelay := 120
+	fillOption := alerts.AlertsFillOptionTypes.NONE
+	aggregationMethod := alerts.NrqlConditionAggregationMethodTypes.EventFlow
+
+	priority := alerts.NrqlConditionPriorities.Critical
+	if c.severity == "warning" {
+		priority = alerts.NrqlConditionPriorities.Warning
+	}
+
+	input := alerts.NrqlConditionCreateInput{}
+	input.Name = fmt.Sprintf("%s %s burn rate (%s window)", name, c.severity, formatServiceLevelAlertWindow(c.window))
+	input.Enabled = enabled
+	input.RunbookURL = runbookURL
+	input.Type = alerts.NrqlConditionTypes.Static
+	input.Nrql = alerts.NrqlConditionCreateQuery{Query: nrql}
+	input.ViolationTimeLimitSeconds = 86400
+	input.Signal = &alerts.AlertsNrqlConditionCreateSignal{
+		AggregationWindow: &window,
+		AggregationMethod: &aggregationMethod,
+		AggregationDelay:  &aggregationDelay,
+		FillOption:        &fillOption,
+	}
+	input.Terms = []alerts.NrqlConditionTerm{
+		{
+			Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE_OR_EQUALS,
+			Priority:             priority,
+			Threshold:            &threshold,
+			ThresholdDuration:    c.slideBy,
+			ThresholdOccurrences: alerts.ThresholdOccurrences.AtLeastOnce,
+		},
+	}
+
+	// The window slides, so that the burn rate is evaluated, and the incident closed,
+	// every `slideBy` seconds rather than once per window.
+	if c.window != c.slideBy {
+		slideBy := c.slideBy
+		input.Signal.SlideBy = &slideBy
+	}
+
+	return input
+}
+
+func expandServiceLevelAlertConditionUpdateInput(input alerts.NrqlConditionCreateInput) alerts.NrqlConditionUpdateInput {
+	update := alerts.NrqlConditionUpdateInput{}
+	update.Name = input.Name
+	update.Enabled = input.Enabled
+	update.RunbookURL = input.RunbookURL
+	update.Type = input.Type
+	update.Nrql = alerts.NrqlConditionUpdateQuery{Query: input.Nrql.Query}
+	update.ViolationTimeLimitSeconds = input.ViolationTimeLimitSeconds
+	update.Terms = input.Terms
+	update.Signal = &alerts.AlertsNrqlConditionUpdateSignal{
+		AggregationWindow: input.Signal.AggregationWindow,
+		AggregationMethod: input.Signal.AggregationMethod,
+		AggregationDelay:  input.Signal.AggregationDelay,
+		FillOption:        input.Signal.FillOption,
+		SlideBy:           input.Signal.SlideBy,
+	}
+
+	return update
+}
@@ -0,0 +1,90 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceLevelAlertConditions(t *testing.T) {
+	conditions := serviceLevelAlertConditions(serviceLevelAlertDefaultWindows, 99.9, 28)
+
+	require.Len(t, conditions, 2)
+
+	require.Equal(t, "critical:3600", conditions[0].key())
+	require.Equal(t, 300, conditions[0].slideBy)
+	require.Equal(t, "warning:21600", conditions[1].key())
+	require.Equal(t, 1800, conditions[1].slideBy)
+
+	// The thresholds match the fast_burn and slow_burn alert types of the helper.
+	require.Equal(t, calculateThreshold(99.9, 2, 28, 3600), conditions[0].threshold)
+	require.Equal(t, calculateThreshold(99.9, 5, 28, 21600), conditions[1].threshold)
+}
+
+func TestValidateServiceLevelAlertWindows(t *testing.T) {
+	require.NoError(t, validateServiceLevelAlertWindows(serviceLevelAlertDefaultWindows))
+
+	require.Error(t, validateServiceLevelAlertWindows([]serviceLevelAlertWindow{
+		{severity: "critical", aggregationWindow: 300, slideBy: 3600, toleratedBudgetConsumption: 2},
+	}))
+
+	require.Error(t, validateServiceLevelAlertWindows([]serviceLevelAlertWindow{
+		{severity: "critical", aggregationWindow: 3600, slideBy: 420, toleratedBudgetConsumption: 2},
+	}))
+
+	require.Error(t, validateServiceLevelAlertWindows([]serviceLevelAlertWindow{
+		{severity: "critical", aggregationWindow: 3600, slideBy: 300, toleratedBudgetConsumption: 2},
+		{severity: "critical", aggregationWindow: 3600, slideBy: 600, toleratedBudgetConsumption: 5},
+	}))
+
+	// The aggregation window of a window can be the slide_by interval of another one.
+	require.NoError(t, validateServiceLevelAlertWindows([]serviceLevelAlertWindow{
+		{severity: "critical", aggregationWindow: 3600, slideBy: 300, toleratedBudgetConsumption: 2},
+		{severity: "critical", aggregationWindow: 21600, slideBy: 3600, toleratedBudgetConsumption: 5},
+	}))
+
+	// The same windows can be used by conditions of another severity.
+	require.NoError(t, validateServiceLevelAlertWindows([]serviceLevelAlertWindow{
+		{severity: "critical", aggregationWindow: 3600, slideBy: 300, toleratedBudgetConsumption: 2},
+		{severity: "warning", aggregationWindow: 3600, slideBy: 300, toleratedBudgetConsumption: 1},
+	}))
+}
+
+func TestServiceLevelAlertConditionsInSync(t *testing.T) {
+	desired := serviceLevelAlertConditions(serviceLevelAlertDefaultWindows, 99.9, 28)
+
+	current := append([]serviceLevelAlertCondition{}, desired...)
+	for i := range current {
+		current[i].conditionID = "123"
+	}
+	require.True(t, serviceLevelAlertConditionsInSync(current, desired))
+
+	require.False(t, serviceLevelAlertConditionsInSync(current[1:], desired))
+	require.False(t, serviceLevelAlertConditionsInSync(current, serviceLevelAlertConditions(serviceLevelAlertDefaultWindows, 99.5, 28)))
+}
+
+func TestExpandServiceLevelAlertConditionInput(t *testing.T) {
+	conditions := serviceLevelAlertConditions(serviceLevelAlertDefaultWindows, 99.9, 28)
+
+	critical := expandServiceLevelAlertConditionInput(conditions[0], "Latency", "FROM Metric SELECT 1", true, "")
+	require.Equal(t, "Latency critical burn rate (1h window)", critical.Name)
+	require.Equal(t, 3600, *critical.Signal.AggregationWindow)
+	require.Equal(t, 300, *critical.Signal.SlideBy)
+	require.Equal(t, alerts.NrqlConditionPriorities.Critical, critical.Terms[0].Priority)
+	require.Equal(t, 300, critical.Terms[0].ThresholdDuration)
+
+	warning := expandServiceLevelAlertConditionInput(conditions[1], "Latency", "FROM Metric SELECT 1", true, "")
+	require.Equal(t, "Latency warning burn rate (6h window)", warning.Name)
+	require.Equal(t, 21600, *warning.Signal.AggregationWindow)
+	require.Equal(t, 1800, *warning.Signal.SlideBy)
+	require.Equal(t, alerts.NrqlConditionPriorities.Warning, warning.Terms[0].Priority)
+
+	update := expandServiceLevelAlertConditionUpdateInput(warning)
+	require.Equal(t, warning.Name, update.Name)
+	require.Equal(t, warning.Signal.SlideBy, update.Signal.SlideBy)
+	require.Equal(t, warning.Terms, update.Terms)
+}
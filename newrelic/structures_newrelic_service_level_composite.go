@@ -0,0 +1,234 @@
+package newrelic
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/servicelevel"
+)
+
+// A service level indicator combined by a newrelic_service_level_composite, with the
+// target of its objective and whether it counts bad events rather than good ones.
+type serviceLevelCompositeComponent struct {
+	sliGUID     string
+	weight      float64
+	target      float64
+	isBadEvents bool
+}
+
+const (
+	serviceLevelCompositeProduct         = "product"
+	serviceLevelCompositeWeightedAverage = "weighted_average"
+)
+
+// The filter of the events of a composite service level on the indicators it combines.
+var serviceLevelCompositeWhereRegexp = regexp.MustCompile(`^entity\.guid IN \(((?:'[^']+'(?:, )?)+)\)$`)
+
+func expandServiceLevelCompositeComponents(cfg []interface{}) []serviceLevelCompositeComponent {
+	components := make([]serviceLevelCompositeComponent, 0, len(cfg))
+
+	for _, c := range cfg {
+		component := c.(map[string]interface{})
+		components = append(components, serviceLevelCompositeComponent{
+			sliGUID: component["sli_guid"].(string),
+			weight:  component["weight"].(float64),
+		})
+	}
+
+	return components
+}
+
+// Returns the target of the journey: the product of the targets of the components, which
+// must all succeed, or their weighted average. The target is rounded down to the 5 decimals
+// the API accepts, so that it is never stricter than the combination.
+func combineServiceLevelCompositeTargets(method string, components []serviceLevelCompositeComponent) float64 {
+	var target float64
+
+	switch method {
+	case serviceLevelCompositeProduct:
+		target = 100
+		for _, c := range components {
+			target *= c.target / 100
+		}
+	case serviceLevelCompositeWeightedAverage:
+		var weights float64
+		for _, c := range components {
+			target += c.weight * c.target
+			weights += c.weight
+		}
+		if weights > 0 {
+			target /= weights
+		}
+	}
+
+	return math.Floor(target*1e5+1e-6) / 1e5
+}
+
+// Returns the ratio of good events of a component, from the metrics of its indicator.
+func serviceLevelCompositeComponentAttainment(c serviceLevelCompositeComponent) string {
+	entity := fmt.Sprintf("WHERE entity.guid = '%s'", c.sliGUID)
+	valid := fmt.Sprintf("filter(sum(newrelic.sli.valid), %s)", entity)
+
+	if c.isBadEvents {
+		return fmt.Sprintf("(1 - filter(sum(newrelic.sli.bad), %s) / %s)", entity, valid)
+	}
+
+	return fmt.Sprintf("(filter(sum(newrelic.sli.good), %s) / %s)", entity, valid)
+}
+
+// Returns the query of the attainment of the journey, combined the same way as the target.
+func serviceLevelCompositeNrql(method string, components []serviceLevelCompositeComponent) string {
+	attainments := make([]string, 0, len(components))
+	var weights float64
+
+	for _, c := range components {
+		attainment := serviceLevelCompositeComponentAttainment(c)
+		if method == serviceLevelCompositeWeightedAverage {
+			attainment = fmt.Sprintf("%s * %s", strconv.FormatFloat(c.weight, 'f', -1, 64), attainment)
+			weights += c.weight
+		}
+		attainments = append(attainments, attainment)
+	}
+
+	var combined string
+	switch method {
+	case serviceLevelCompositeProduct:
+		combined = fmt.Sprintf("100 * %s", strings.Join(attainments, " * "))
+	case serviceLevelCompositeWeightedAverage:
+		combined = fmt.Sprintf("100 * (%s) / %s", strings.Join(attainments, " + "), strconv.FormatFloat(weights, 'f', -1, 64))
+	}
+
+	return fmt.Sprintf("FROM Metric SELECT %s AS 'Attainment' WHERE %s", combined, serviceLevelCompositeWhere(components))
+}
+
+// Returns an error when the weights of the components can't be averaged.
+func validateServiceLevelCompositeWeights(method string, components []serviceLevelCompositeComponent) error {
+	if method != serviceLevelCompositeWeightedAverage {
+		return nil
+	}
+
+	for _, c := range components {
+		if c.weight > 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("at least one component must have a weight greater than 0 with the %s method", serviceLevelCompositeWeightedAverage)
+}
+
+func serviceLevelCompositeWhere(components []serviceLevelCompositeComponent) string {
+	guids := make([]string, 0, len(components))
+	for _, c := range components {
+		guids = append(guids, fmt.Sprintf("'%s'", c.sliGUID))
+	}
+
+	return fmt.Sprintf("entity.guid IN (%s)", strings.Join(guids, ", "))
+}
+
+// Returns the events of the composite indicator: the events of every component, summed
+// from the metrics of their indicators. The components must all count either good or
+// bad events, as an indicator only records the metric of the events it is defined with.
+func expandServiceLevelCompositeEvents(accountID int, components []serviceLevelCompositeComponent) (servicelevel.ServiceLevelEventsCreateInput, error) {
+	events := servicelevel.ServiceLevelEventsCreateInput{AccountID: accountID}
+
+	for _, c := range components[1:] {
+		if c.isBadEvents != components[0].isBadEvents {
+			return events, fmt.Errorf("service levels %s and %s cannot be combined, as one counts good events and the other bad events", components[0].sliGUID, c.sliGUID)
+		}
+	}
+
+	query := func(metric string) *servicelevel.ServiceLevelEventsQueryCreateInput {
+		return &servicelevel.ServiceLevelEventsQueryCreateInput{
+			From:  "Metric",
+			Where: servicelevel.NRQL(serviceLevelCompositeWhere(components)),
+			Select: &servicelevel.ServiceLevelEventsQuerySelectCreateInput{
+				Attribute: metric,
+				Function:  servicelevel.ServiceLevelEventsQuerySelectFunctionTypes.SUM,
+			},
+		}
+	}
+
+	events.ValidEvents = query("newrelic.sli.valid")
+	if components[0].isBadEvents {
+		events.BadEvents = query("newrelic.sli.bad")
+	} else {
+		events.GoodEvents = query("newrelic.sli.good")
+	}
+
+	return events, nil
+}
+
+func expandServiceLevelCompositeEventsUpdateInput(events servicelevel.ServiceLevelEventsCreateInput) *servicelevel.ServiceLevelEventsUpdateInput {
+	query := func(q *servicelevel.ServiceLevelEventsQueryCreateInput) *servicelevel.ServiceLevelEventsQueryUpdateInput {
+		if q == nil {
+			return nil
+		}
+
+		return &servicelevel.ServiceLevelEventsQueryUpdateInput{
+			From:  q.From,
+			Where: q.Where,
+			Select: &servicelevel.ServiceLevelEventsQuerySelectUpdateInput{
+				Attribute: q.Select.Attribute,
+				Function:  q.Select.Function,
+			},
+		}
+	}
+
+	return &servicelevel.ServiceLevelEventsUpdateInput{
+		ValidEvents: query(events.ValidEvents),
+		GoodEvents:  query(events.GoodEvents),
+		BadEvents:   query(events.BadEvents),
+	}
+}
+
+// Returns the components of the events of a composite indicator, or nil when the events
+// are not the sums of the metrics of other indicators any more, e.g. after they were
+// changed outside of Terraform, so that the next plan restores them. The events don't
+// hold the weights, which are kept from `weights`, and default to 1.
+func flattenServiceLevelCompositeComponents(events servicelevel.ServiceLevelEvents, weights map[string]float64) []interface{} {
+	queries := []*servicelevel.ServiceLevelEventsQuery{events.ValidEvents}
+	metrics := []string{"newrelic.sli.valid"}
+
+	switch {
+	case events.GoodEvents != nil && events.BadEvents == nil:
+		queries = append(queries, events.GoodEvents)
+		metrics = append(metrics, "newrelic.sli.good")
+	case events.BadEvents != nil && events.GoodEvents == nil:
+		queries = append(queries, events.BadEvents)
+		metrics = append(metrics, "newrelic.sli.bad")
+	default:
+		return nil
+	}
+
+	for i, q := range queries {
+		if q == nil || q.From != "Metric" || q.Where != queries[0].Where ||
+			q.Select.Attribute != metrics[i] || q.Select.Function != servicelevel.ServiceLevelEventsQuerySelectFunctionTypes.SUM {
+			return nil
+		}
+	}
+
+	match := serviceLevelCompositeWhereRegexp.FindStringSubmatch(string(queries[0].Where))
+	if match == nil {
+		return nil
+	}
+
+	components := []interface{}{}
+	for _, guid := range strings.Split(match[1], ", ") {
+		guid = strings.Trim(guid, "'")
+
+		weight, ok := weights[guid]
+		if !ok {
+			weight = 1
+		}
+
+		components = append(components, map[string]interface{}{
+			"sli_guid": guid,
+			"weight":   weight,
+		})
+	}
+
+	return components
+}
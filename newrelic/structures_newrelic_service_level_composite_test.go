@@ -0,0 +1,139 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/servicelevel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombineServiceLevelCompositeTargets(t *testing.T) {
+	components := []serviceLevelCompositeComponent{
+		{sliGUID: "a", weight: 3, target: 99.9},
+		{sliGUID: "b", weight: 1, target: 99},
+	}
+
+	// 99.9% * 99% of the journeys succeed when both components meet their targets.
+	require.Equal(t, 98.901, combineServiceLevelCompositeTargets(serviceLevelCompositeProduct, components))
+	// (3 * 99.9 + 1 * 99) / 4
+	require.Equal(t, 99.675, combineServiceLevelCompositeTargets(serviceLevelCompositeWeightedAverage, components))
+
+	// The product is rounded down to the 5 decimals accepted by the API: 99.9^3 = 99.7002999.
+	components = []serviceLevelCompositeComponent{
+		{sliGUID: "a", target: 99.9},
+		{sliGUID: "b", target: 99.9},
+		{sliGUID: "c", target: 99.9},
+	}
+	require.Equal(t, 99.70029, combineServiceLevelCompositeTargets(serviceLevelCompositeProduct, components))
+
+	// Components without weight don't count in the average.
+	components = []serviceLevelCompositeComponent{
+		{sliGUID: "a", weight: 1, target: 99.5},
+		{sliGUID: "b", weight: 0, target: 90},
+	}
+	require.Equal(t, 99.5, combineServiceLevelCompositeTargets(serviceLevelCompositeWeightedAverage, components))
+}
+
+func TestServiceLevelCompositeNrql(t *testing.T) {
+	components := []serviceLevelCompositeComponent{
+		{sliGUID: "a", weight: 3},
+		{sliGUID: "b", weight: 1},
+	}
+
+	require.Equal(t,
+		"FROM Metric SELECT 100 * "+
+			"(filter(sum(newrelic.sli.good), WHERE entity.guid = 'a') / filter(sum(newrelic.sli.valid), WHERE entity.guid = 'a')) * "+
+			"(filter(sum(newrelic.sli.good), WHERE entity.guid = 'b') / filter(sum(newrelic.sli.valid), WHERE entity.guid = 'b')) "+
+			"AS 'Attainment' WHERE entity.guid IN ('a', 'b')",
+		serviceLevelCompositeNrql(serviceLevelCompositeProduct, components))
+
+	require.Equal(t,
+		"FROM Metric SELECT 100 * ("+
+			"3 * (filter(sum(newrelic.sli.good), WHERE entity.guid = 'a') / filter(sum(newrelic.sli.valid), WHERE entity.guid = 'a')) + "+
+			"1 * (filter(sum(newrelic.sli.good), WHERE entity.guid = 'b') / filter(sum(newrelic.sli.valid), WHERE entity.guid = 'b'))"+
+			") / 4 AS 'Attainment' WHERE entity.guid IN ('a', 'b')",
+		serviceLevelCompositeNrql(serviceLevelCompositeWeightedAverage, components))
+
+	// Components defined with bad events count the good events as the valid events which are not bad.
+	require.Equal(t,
+		"(1 - filter(sum(newrelic.sli.bad), WHERE entity.guid = 'a') / filter(sum(newrelic.sli.valid), WHERE entity.guid = 'a'))",
+		serviceLevelCompositeComponentAttainment(serviceLevelCompositeComponent{sliGUID: "a", isBadEvents: true}))
+}
+
+func TestValidateServiceLevelCompositeWeights(t *testing.T) {
+	components := []serviceLevelCompositeComponent{
+		{sliGUID: "a", weight: 0},
+		{sliGUID: "b", weight: 0},
+	}
+
+	require.NoError(t, validateServiceLevelCompositeWeights(serviceLevelCompositeProduct, components))
+	require.Error(t, validateServiceLevelCompositeWeights(serviceLevelCompositeWeightedAverage, components))
+
+	components[1].weight = 0.5
+	require.NoError(t, validateServiceLevelCompositeWeights(serviceLevelCompositeWeightedAverage, components))
+}
+
+func TestExpandServiceLevelCompositeEvents(t *testing.T) {
+	components := []serviceLevelCompositeComponent{
+		{sliGUID: "a", isBadEvents: true},
+		{sliGUID: "b", isBadEvents: true},
+	}
+
+	events, err := expandServiceLevelCompositeEvents(12345, components)
+	require.NoError(t, err)
+	require.Equal(t, 12345, events.AccountID)
+	require.Nil(t, events.GoodEvents)
+	require.Equal(t, servicelevel.NRQL("Metric"), events.ValidEvents.From)
+	require.Equal(t, servicelevel.NRQL("entity.guid IN ('a', 'b')"), events.ValidEvents.Where)
+	require.Equal(t, "newrelic.sli.valid", events.ValidEvents.Select.Attribute)
+	require.Equal(t, "newrelic.sli.bad", events.BadEvents.Select.Attribute)
+	require.Equal(t, servicelevel.ServiceLevelEventsQuerySelectFunctionTypes.SUM, events.BadEvents.Select.Function)
+
+	update := expandServiceLevelCompositeEventsUpdateInput(events)
+	require.Nil(t, update.GoodEvents)
+	require.Equal(t, events.BadEvents.Where, update.BadEvents.Where)
+	require.Equal(t, "newrelic.sli.bad", update.BadEvents.Select.Attribute)
+
+	components[1].isBadEvents = false
+	_, err = expandServiceLevelCompositeEvents(12345, components)
+	require.Error(t, err)
+}
+
+func TestFlattenServiceLevelCompositeComponents(t *testing.T) {
+	query := func(metric string) *servicelevel.ServiceLevelEventsQuery {
+		return &servicelevel.ServiceLevelEventsQuery{
+			From:  "Metric",
+			Where: "entity.guid IN ('a', 'b')",
+			Select: servicelevel.ServiceLevelEventsQuerySelect{
+				Attribute: metric,
+				Function:  servicelevel.ServiceLevelEventsQuerySelectFunctionTypes.SUM,
+			},
+		}
+	}
+
+	// The weights are kept from state, and default to 1.
+	components := flattenServiceLevelCompositeComponents(servicelevel.ServiceLevelEvents{
+		ValidEvents: query("newrelic.sli.valid"),
+		GoodEvents:  query("newrelic.sli.good"),
+	}, map[string]float64{"a": 3})
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"sli_guid": "a", "weight": 3.0},
+		map[string]interface{}{"sli_guid": "b", "weight": 1.0},
+	}, components)
+
+	// Events changed outside of Terraform.
+	changed := query("newrelic.sli.bad")
+	changed.Where = "entity.guid IN ('a')"
+	require.Nil(t, flattenServiceLevelCompositeComponents(servicelevel.ServiceLevelEvents{
+		ValidEvents: query("newrelic.sli.valid"),
+		BadEvents:   changed,
+	}, nil))
+
+	require.Nil(t, flattenServiceLevelCompositeComponents(servicelevel.ServiceLevelEvents{
+		ValidEvents: &servicelevel.ServiceLevelEventsQuery{From: "Transaction", Where: "appName = 'a'"},
+		BadEvents:   &servicelevel.ServiceLevelEventsQuery{From: "TransactionError", Where: "appName = 'a'"},
+	}, nil))
+}
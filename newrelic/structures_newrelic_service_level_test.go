@@ -0,0 +1,54 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testServiceLevelObjective(name string, target float64, count int) map[string]interface{} {
+	return map[string]interface{}{
+		"name":   name,
+		"target": target,
+		"time_window": []interface{}{
+			map[string]interface{}{
+				"rolling": []interface{}{
+					map[string]interface{}{"count": count, "unit": "DAY"},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateServiceLevelObjectiveTarget(t *testing.T) {
+	for _, target := range []float64{0.5, 99, 99.9, 99.99999} {
+		_, errs := validateServiceLevelObjectiveTarget(target, "target")
+		require.Empty(t, errs, target)
+	}
+
+	for _, target := range []float64{0, 100, -1, 99.999999} {
+		_, errs := validateServiceLevelObjectiveTarget(target, "target")
+		require.Len(t, errs, 1, target)
+	}
+}
+
+func TestValidateServiceLevelObjectives(t *testing.T) {
+	require.NoError(t, validateServiceLevelObjectives([]interface{}{
+		testServiceLevelObjective("weekly", 99.9, 7),
+	}))
+
+	err := validateServiceLevelObjectives([]interface{}{
+		testServiceLevelObjective("weekly", 99.9, 7),
+		testServiceLevelObjective("", 99.5, 7),
+	})
+	require.EqualError(t, err, `objectives "weekly" and #2 conflict as they both use a rolling 7 DAY time window, only one objective can be defined`)
+
+	// Objectives on different windows are rejected by MaxItems.
+	require.NoError(t, validateServiceLevelObjectives([]interface{}{
+		testServiceLevelObjective("weekly", 99.9, 7),
+		testServiceLevelObjective("monthly", 99.5, 28),
+	}))
+}
@@ -0,0 +1,150 @@
+package newrelic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/synthetics"
+)
+
+// syntheticsMonitorSetTemplate holds the configuration shared by every monitor
+// managed by a `newrelic_synthetics_monitor_set` resource.
+type syntheticsMonitorSetTemplate struct {
+	Type                               string
+	Period                             synthetics.SyntheticsMonitorPeriod
+	Status                             synthetics.SyntheticsMonitorStatus
+	Tags                               []synthetics.SyntheticsTag
+	Locations                          synthetics.SyntheticsLocationsInput
+	CustomHeaders                      []synthetics.SyntheticsCustomHeaderInput
+	ValidationString                   string
+	VerifySSL                          bool
+	BypassHeadRequest                  bool
+	TreatRedirectAsFailure             bool
+	EnableScreenshotOnFailureAndScript bool
+	Browsers                           []synthetics.SyntheticsBrowser
+	Devices                            []synthetics.SyntheticsDevice
+	Runtime                            *synthetics.SyntheticsRuntimeInput
+}
+
+func expandSyntheticsMonitorSetTemplate(cfg map[string]interface{}) syntheticsMonitorSetTemplate {
+	template := syntheticsMonitorSetTemplate{
+		Type:                               cfg["type"].(string),
+		Period:                             synthetics.SyntheticsMonitorPeriod(cfg["period"].(string)),
+		Status:                             synthetics.SyntheticsMonitorStatus(cfg["status"].(string)),
+		ValidationString:                   cfg["validation_string"].(string),
+		VerifySSL:                          cfg["verify_ssl"].(bool),
+		BypassHeadRequest:                  cfg["bypass_head_request"].(bool),
+		TreatRedirectAsFailure:             cfg["treat_redirect_as_failure"].(bool),
+		EnableScreenshotOnFailureAndScript: cfg["enable_screenshot_on_failure_and_script"].(bool),
+		CustomHeaders:                      []synthetics.SyntheticsCustomHeaderInput{},
+	}
+
+	if v, ok := cfg["tag"]; ok && v.(*schema.Set).Len() > 0 {
+		template.Tags = expandSyntheticsTags(v.(*schema.Set).List())
+	}
+
+	if v, ok := cfg["locations_public"]; ok && v.(*schema.Set).Len() > 0 {
+		template.Locations.Public = expandStringSlice(v.(*schema.Set).List())
+	}
+
+	if v, ok := cfg["locations_private"]; ok && v.(*schema.Set).Len() > 0 {
+		template.Locations.Private = expandStringSlice(v.(*schema.Set).List())
+	}
+
+	if v, ok := cfg["custom_header"]; ok {
+		template.CustomHeaders = expandSyntheticsCustomHeaders(v.(*schema.Set).List())
+	}
+
+	if v, ok := cfg["browsers"]; ok && v.(*schema.Set).Len() > 0 {
+		template.Browsers = expandSyntheticsBrowsers(v.(*schema.Set).List())
+	}
+
+	if v, ok := cfg["devices"]; ok && v.(*schema.Set).Len() > 0 {
+		template.Devices = expandSyntheticsDevices(v.(*schema.Set).List())
+	}
+
+	runtimeType := cfg["runtime_type"].(string)
+	runtimeTypeVersion := cfg["runtime_type_version"].(string)
+	scriptLanguage := cfg["script_language"].(string)
+
+	if runtimeType != "" || runtimeTypeVersion != "" || scriptLanguage != "" {
+		template.Runtime = &synthetics.SyntheticsRuntimeInput{
+			RuntimeType:        runtimeType,
+			RuntimeTypeVersion: synthetics.SemVer(runtimeTypeVersion),
+			ScriptLanguage:     scriptLanguage,
+		}
+	}
+
+	return template
+}
+
+func buildSyntheticsMonitorSetSimpleMonitorInput(template syntheticsMonitorSetTemplate, name string, uri string) synthetics.SyntheticsCreateSimpleMonitorInput {
+	customHeaders := template.CustomHeaders
+
+	return synthetics.SyntheticsCreateSimpleMonitorInput{
+		Name:      name,
+		Uri:       uri,
+		Period:    template.Period,
+		Status:    template.Status,
+		Tags:      template.Tags,
+		Locations: template.Locations,
+		AdvancedOptions: synthetics.SyntheticsSimpleMonitorAdvancedOptionsInput{
+			CustomHeaders:           &customHeaders,
+			ResponseValidationText:  template.ValidationString,
+			UseTlsValidation:        getBoolPointer(template.VerifySSL),
+			ShouldBypassHeadRequest: getBoolPointer(template.BypassHeadRequest),
+			RedirectIsFailure:       getBoolPointer(template.TreatRedirectAsFailure),
+		},
+	}
+}
+
+func buildSyntheticsMonitorSetSimpleMonitorUpdateInput(template syntheticsMonitorSetTemplate, name string, uri string) synthetics.SyntheticsUpdateSimpleMonitorInput {
+	input := buildSyntheticsMonitorSetSimpleMonitorInput(template, name, uri)
+
+	return synthetics.SyntheticsUpdateSimpleMonitorInput{
+		Name:            input.Name,
+		Uri:             input.Uri,
+		Period:          input.Period,
+		Status:          input.Status,
+		Tags:            input.Tags,
+		Locations:       input.Locations,
+		AdvancedOptions: input.AdvancedOptions,
+	}
+}
+
+func buildSyntheticsMonitorSetSimpleBrowserMonitorInput(template syntheticsMonitorSetTemplate, name string, uri string) synthetics.SyntheticsCreateSimpleBrowserMonitorInput {
+	customHeaders := template.CustomHeaders
+
+	return synthetics.SyntheticsCreateSimpleBrowserMonitorInput{
+		Name:      name,
+		Uri:       uri,
+		Period:    template.Period,
+		Status:    template.Status,
+		Tags:      template.Tags,
+		Locations: template.Locations,
+		Browsers:  template.Browsers,
+		Devices:   template.Devices,
+		Runtime:   template.Runtime,
+		AdvancedOptions: synthetics.SyntheticsSimpleBrowserMonitorAdvancedOptionsInput{
+			CustomHeaders:                      &customHeaders,
+			ResponseValidationText:             template.ValidationString,
+			UseTlsValidation:                   getBoolPointer(template.VerifySSL),
+			EnableScreenshotOnFailureAndScript: getBoolPointer(template.EnableScreenshotOnFailureAndScript),
+		},
+	}
+}
+
+func buildSyntheticsMonitorSetSimpleBrowserMonitorUpdateInput(template syntheticsMonitorSetTemplate, name string, uri string) synthetics.SyntheticsUpdateSimpleBrowserMonitorInput {
+	input := buildSyntheticsMonitorSetSimpleBrowserMonitorInput(template, name, uri)
+
+	return synthetics.SyntheticsUpdateSimpleBrowserMonitorInput{
+		Name:            input.Name,
+		Uri:             input.Uri,
+		Period:          input.Period,
+		Status:          input.Status,
+		Tags:            input.Tags,
+		Locations:       input.Locations,
+		Browsers:        input.Browsers,
+		Devices:         input.Devices,
+		Runtime:         input.Runtime,
+		AdvancedOptions: input.AdvancedOptions,
+	}
+}
@@ -0,0 +1,82 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/synthetics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandSyntheticsMonitorSetTemplate(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitorSet()
+	d := r.TestResourceData()
+
+	err := d.Set("template", []interface{}{
+		map[string]interface{}{
+			"type":                 "BROWSER",
+			"period":               "EVERY_5_MINUTES",
+			"status":               "ENABLED",
+			"locations_public":     []interface{}{"US_EAST_1", "AP_SOUTH_1"},
+			"validation_string":    "ok",
+			"verify_ssl":           true,
+			"runtime_type":         "CHROME_BROWSER",
+			"runtime_type_version": "100",
+			"script_language":      "JAVASCRIPT",
+			"tag": []interface{}{
+				map[string]interface{}{
+					"key":    "team",
+					"values": []interface{}{"platform"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	template := expandSyntheticsMonitorSetTemplate(d.Get("template.0").(map[string]interface{}))
+
+	require.Equal(t, "BROWSER", template.Type)
+	require.Equal(t, synthetics.SyntheticsMonitorPeriodTypes.EVERY_5_MINUTES, template.Period)
+	require.ElementsMatch(t, []string{"US_EAST_1", "AP_SOUTH_1"}, template.Locations.Public)
+	require.Empty(t, template.Locations.Private)
+	require.Equal(t, []synthetics.SyntheticsTag{{Key: "team", Values: []string{"platform"}}}, template.Tags)
+	require.NotNil(t, template.Runtime)
+	require.Equal(t, synthetics.SemVer("100"), template.Runtime.RuntimeTypeVersion)
+
+	input := buildSyntheticsMonitorSetSimpleBrowserMonitorInput(template, "home", "https://example.com")
+	require.Equal(t, "home", input.Name)
+	require.Equal(t, "https://example.com", input.Uri)
+	require.Equal(t, "ok", input.AdvancedOptions.ResponseValidationText)
+	require.True(t, *input.AdvancedOptions.UseTlsValidation)
+
+	update := buildSyntheticsMonitorSetSimpleBrowserMonitorUpdateInput(template, "home", "https://example.com/v2")
+	require.Equal(t, "https://example.com/v2", update.Uri)
+	require.Equal(t, template.Runtime, update.Runtime)
+}
+
+func TestExpandSyntheticsMonitorSetTemplate_SimpleWithoutRuntime(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitorSet()
+	d := r.TestResourceData()
+
+	err := d.Set("template", []interface{}{
+		map[string]interface{}{
+			"type":              "SIMPLE",
+			"period":            "EVERY_HOUR",
+			"status":            "DISABLED",
+			"locations_private": []interface{}{"private-location-guid"},
+		},
+	})
+	require.NoError(t, err)
+
+	template := expandSyntheticsMonitorSetTemplate(d.Get("template.0").(map[string]interface{}))
+	require.Equal(t, "SIMPLE", template.Type)
+	require.Nil(t, template.Runtime)
+
+	input := buildSyntheticsMonitorSetSimpleMonitorUpdateInput(template, "api", "https://api.example.com")
+	require.Equal(t, []string{"private-location-guid"}, input.Locations.Private)
+	require.NotNil(t, input.AdvancedOptions.CustomHeaders)
+	require.Empty(t, *input.AdvancedOptions.CustomHeaders)
+	require.False(t, *input.AdvancedOptions.RedirectIsFailure)
+}
@@ -0,0 +1,251 @@
+package newrelic
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workloads"
+)
+
+// A member entity of a workload, with the status its alert severity rolls up as.
+type workloadStatusEntity struct {
+	guid       string
+	entityType string
+	status     workloads.WorkloadStatusValue
+}
+
+// The status of a workload, as computed by previewWorkloadStatus.
+type workloadStatusPreview struct {
+	status          workloads.WorkloadStatusValue
+	source          workloads.WorkloadStatusSource
+	ruleStatuses    []workloads.WorkloadStatusValue
+	remainingStatus workloads.WorkloadStatusValue
+}
+
+// The statuses of entities by increasing severity. Unknown statuses are not rolled up.
+var workloadStatusSeverities = map[workloads.WorkloadStatusValue]int{
+	workloads.WorkloadStatusValueTypes.OPERATIONAL: 1,
+	workloads.WorkloadStatusValueTypes.DEGRADED:    2,
+	workloads.WorkloadStatusValueTypes.DISRUPTED:   3,
+}
+
+func listValidWorkloadEntityAlertSeverities() []string {
+	return []string{"CRITICAL", "WARNING", "NOT_ALERTING", "NOT_CONFIGURED"}
+}
+
+// Returns the status an entity rolls up as, from its alert severity.
+func workloadEntityStatus(alertSeverity string) workloads.WorkloadStatusValue {
+	switch alertSeverity {
+	case "CRITICAL":
+		return workloads.WorkloadStatusValueTypes.DISRUPTED
+	case "WARNING":
+		return workloads.WorkloadStatusValueTypes.DEGRADED
+	case "NOT_ALERTING":
+		return workloads.WorkloadStatusValueTypes.OPERATIONAL
+	}
+
+	return workloads.WorkloadStatusValueTypes.UNKNOWN
+}
+
+// Rolls up the statuses of a group of entities. With the worst status wins strategy, a
+// threshold rolls up the worst status only once at least `thresholdValue` entities, or
+// percent of the entities, are not operational; the group is operational until then.
+func rollupWorkloadStatus(strategy workloads.WorkloadRollupStrategy, thresholdType workloads.WorkloadRuleThresholdType, thresholdValue int, statuses []workloads.WorkloadStatusValue) workloads.WorkloadStatusValue {
+	known := []workloads.WorkloadStatusValue{}
+	for _, s := range statuses {
+		if workloadStatusSeverities[s] > 0 {
+			known = append(known, s)
+		}
+	}
+
+	if len(known) == 0 {
+		return workloads.WorkloadStatusValueTypes.UNKNOWN
+	}
+
+	sort.Slice(known, func(i, j int) bool {
+		return workloadStatusSeverities[known[i]] < workloadStatusSeverities[known[j]]
+	})
+
+	if strategy == workloads.WorkloadRollupStrategyTypes.BEST_STATUS_WINS {
+		return known[0]
+	}
+
+	notOperational := 0
+	for _, s := range known {
+		if s != workloads.WorkloadStatusValueTypes.OPERATIONAL {
+			notOperational++
+		}
+	}
+
+	switch thresholdType {
+	case workloads.WorkloadRuleThresholdTypeTypes.FIXED:
+		if notOperational < thresholdValue {
+			return workloads.WorkloadStatusValueTypes.OPERATIONAL
+		}
+	case workloads.WorkloadRuleThresholdTypeTypes.PERCENTAGE:
+		if notOperational*100 < thresholdValue*len(known) {
+			return workloads.WorkloadStatusValueTypes.OPERATIONAL
+		}
+	}
+
+	return known[len(known)-1]
+}
+
+// Returns the worst of the statuses, or an unknown status when none is known.
+func worstWorkloadStatus(statuses []workloads.WorkloadStatusValue) workloads.WorkloadStatusValue {
+	return rollupWorkloadStatus(workloads.WorkloadRollupStrategyTypes.WORST_STATUS_WINS, "", 0, statuses)
+}
+
+// Computes the status of a workload the way New Relic rolls it up: an enabled static
+// status wins; otherwise, the workload has the worst status of its rules and of its
+// remaining entities rule. `ruleMembers` holds the GUIDs of the entities of each rule,
+// in the order of the rules; entities of no rule are the remaining entities.
+func previewWorkloadStatus(automatic *workloads.WorkloadAutomaticStatusInput, static []workloads.WorkloadStaticStatusInput, entities []workloadStatusEntity, ruleMembers [][]string) workloadStatusPreview {
+	preview := workloadStatusPreview{
+		status:          workloads.WorkloadStatusValueTypes.UNKNOWN,
+		source:          workloads.WorkloadStatusSourceTypes.UNKNOWN,
+		ruleStatuses:    []workloads.WorkloadStatusValue{},
+		remainingStatus: workloads.WorkloadStatusValueTypes.UNKNOWN,
+	}
+
+	if automatic != nil {
+		byGUID := map[string]workloadStatusEntity{}
+		for _, e := range entities {
+			byGUID[e.guid] = e
+		}
+
+		covered := map[string]bool{}
+		for i, rule := range automatic.Rules {
+			statuses := []workloads.WorkloadStatusValue{}
+			if i < len(ruleMembers) {
+				for _, guid := range ruleMembers[i] {
+					covered[guid] = true
+					if e, ok := byGUID[guid]; ok {
+						statuses = append(statuses, e.status)
+					}
+				}
+			}
+
+			status := workloads.WorkloadStatusValueTypes.UNKNOWN
+			if rule.Rollup != nil {
+				status = rollupWorkloadStatus(rule.Rollup.Strategy, rule.Rollup.ThresholdType, rule.Rollup.ThresholdValue, statuses)
+			}
+			preview.ruleStatuses = append(preview.ruleStatuses, status)
+		}
+
+		if automatic.RemainingEntitiesRule != nil && automatic.RemainingEntitiesRule.Rollup != nil {
+			rollup := automatic.RemainingEntitiesRule.Rollup
+
+			groups := map[string][]workloads.WorkloadStatusValue{}
+			for _, e := range entities {
+				if covered[e.guid] {
+					continue
+				}
+
+				group := ""
+				if rollup.GroupBy == workloads.WorkloadGroupRemainingEntitiesRuleByTypes.ENTITY_TYPE {
+					group = e.entityType
+				}
+				groups[group] = append(groups[group], e.status)
+			}
+
+			groupStatuses := []workloads.WorkloadStatusValue{}
+			for _, statuses := range groups {
+				groupStatuses = append(groupStatuses, rollupWorkloadStatus(rollup.Strategy, rollup.ThresholdType, rollup.ThresholdValue, statuses))
+			}
+			preview.remainingStatus = worstWorkloadStatus(groupStatuses)
+		}
+
+		if automatic.Enabled {
+			preview.status = worstWorkloadStatus(append([]workloads.WorkloadStatusValue{preview.remainingStatus}, preview.ruleStatuses...))
+			preview.source = workloads.WorkloadStatusSourceTypes.ROLLUP_RULE
+		}
+	}
+
+	for _, s := range static {
+		if s.Enabled {
+			preview.status = workloads.WorkloadStatusValue(s.Status)
+			preview.source = workloads.WorkloadStatusSourceTypes.STATIC
+		}
+	}
+
+	return preview
+}
+
+// Returns the GUIDs of the entities of each rule, in the order of the rules, as far as
+// they are known without resolving the entity search queries of the rules.
+func workloadStatusRuleGUIDs(automatic *workloads.WorkloadAutomaticStatusInput) [][]string {
+	ruleMembers := [][]string{}
+	if automatic == nil {
+		return ruleMembers
+	}
+
+	for _, rule := range automatic.Rules {
+		guids := []string{}
+		for _, guid := range rule.EntityGUIDs {
+			guids = append(guids, string(guid))
+		}
+		ruleMembers = append(ruleMembers, guids)
+	}
+
+	return ruleMembers
+}
+
+// With a remaining entities rule, each entity is rolled up by the rules it belongs to,
+// or by the remaining entities rule otherwise. An entity in more than one rule weighs
+// more than the others in the status of the workload, which New Relic accepts, so the
+// entities of `ruleMembers` and the entity search queries belonging to more than one
+// rule are reported as warnings.
+func workloadStatusRulesOverlapWarnings(automatic *workloads.WorkloadAutomaticStatusInput, ruleMembers [][]string) diag.Diagnostics {
+	if automatic == nil || automatic.RemainingEntitiesRule == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	rules := map[string]int{}
+	for _, members := range ruleMembers {
+		seen := map[string]bool{}
+		for _, guid := range members {
+			if guid == "" || seen[guid] {
+				continue
+			}
+			seen[guid] = true
+			rules[guid]++
+		}
+	}
+
+	guids := []string{}
+	for guid, count := range rules {
+		if count > 1 {
+			guids = append(guids, guid)
+		}
+	}
+	sort.Strings(guids)
+
+	for _, guid := range guids {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("entity %s belongs to more than one rule, and is counted more than once along with the remaining entities rule", guid),
+		})
+	}
+
+	queries := map[string]bool{}
+	for _, rule := range automatic.Rules {
+		for _, q := range rule.EntitySearchQueries {
+			if q.Query == "" {
+				continue
+			}
+			if queries[q.Query] {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("the entity search query %q belongs to more than one rule, and its entities are counted more than once along with the remaining entities rule", q.Query),
+				})
+			}
+			queries[q.Query] = true
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,133 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workloads"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testWorkloadOperational = workloads.WorkloadStatusValueTypes.OPERATIONAL
+	testWorkloadDegraded    = workloads.WorkloadStatusValueTypes.DEGRADED
+	testWorkloadDisrupted   = workloads.WorkloadStatusValueTypes.DISRUPTED
+	testWorkloadUnknown     = workloads.WorkloadStatusValueTypes.UNKNOWN
+)
+
+func TestWorkloadEntityStatus(t *testing.T) {
+	require.Equal(t, testWorkloadDisrupted, workloadEntityStatus("CRITICAL"))
+	require.Equal(t, testWorkloadDegraded, workloadEntityStatus("WARNING"))
+	require.Equal(t, testWorkloadOperational, workloadEntityStatus("NOT_ALERTING"))
+	require.Equal(t, testWorkloadUnknown, workloadEntityStatus("NOT_CONFIGURED"))
+}
+
+func TestRollupWorkloadStatus(t *testing.T) {
+	worst := workloads.WorkloadRollupStrategyTypes.WORST_STATUS_WINS
+	best := workloads.WorkloadRollupStrategyTypes.BEST_STATUS_WINS
+	fixed := workloads.WorkloadRuleThresholdTypeTypes.FIXED
+	percentage := workloads.WorkloadRuleThresholdTypeTypes.PERCENTAGE
+
+	statuses := []workloads.WorkloadStatusValue{testWorkloadOperational, testWorkloadDegraded, testWorkloadOperational, testWorkloadUnknown}
+
+	require.Equal(t, testWorkloadDegraded, rollupWorkloadStatus(worst, "", 0, statuses))
+	require.Equal(t, testWorkloadOperational, rollupWorkloadStatus(best, "", 0, statuses))
+
+	// One of the three known entities is not operational.
+	require.Equal(t, testWorkloadDegraded, rollupWorkloadStatus(worst, fixed, 1, statuses))
+	require.Equal(t, testWorkloadOperational, rollupWorkloadStatus(worst, fixed, 2, statuses))
+	require.Equal(t, testWorkloadDegraded, rollupWorkloadStatus(worst, percentage, 33, statuses))
+	require.Equal(t, testWorkloadOperational, rollupWorkloadStatus(worst, percentage, 34, statuses))
+
+	require.Equal(t, testWorkloadUnknown, rollupWorkloadStatus(worst, "", 0, []workloads.WorkloadStatusValue{testWorkloadUnknown}))
+	require.Equal(t, testWorkloadUnknown, rollupWorkloadStatus(best, "", 0, nil))
+}
+
+func TestPreviewWorkloadStatus(t *testing.T) {
+	entities := []workloadStatusEntity{
+		{guid: "a", entityType: "APPLICATION", status: testWorkloadOperational},
+		{guid: "b", entityType: "APPLICATION", status: testWorkloadDisrupted},
+		{guid: "c", entityType: "HOST", status: testWorkloadOperational},
+		{guid: "d", entityType: "HOST", status: testWorkloadDegraded},
+		{guid: "e", entityType: "APPLICATION", status: testWorkloadOperational},
+	}
+
+	automatic := &workloads.WorkloadAutomaticStatusInput{
+		Enabled: true,
+		Rules: []workloads.WorkloadRegularRuleInput{
+			{Rollup: &workloads.WorkloadRollupInput{Strategy: workloads.WorkloadRollupStrategyTypes.BEST_STATUS_WINS}},
+		},
+		RemainingEntitiesRule: &workloads.WorkloadRemainingEntitiesRuleInput{
+			Rollup: &workloads.WorkloadRemainingEntitiesRuleRollupInput{
+				GroupBy:  workloads.WorkloadGroupRemainingEntitiesRuleByTypes.NONE,
+				Strategy: workloads.WorkloadRollupStrategyTypes.WORST_STATUS_WINS,
+			},
+		},
+	}
+
+	// The rule of a and b rolls up their best status, the remaining entities their worst.
+	preview := previewWorkloadStatus(automatic, nil, entities, [][]string{{"a", "b"}})
+	require.Equal(t, []workloads.WorkloadStatusValue{testWorkloadOperational}, preview.ruleStatuses)
+	require.Equal(t, testWorkloadDegraded, preview.remainingStatus)
+	require.Equal(t, testWorkloadDegraded, preview.status)
+	require.Equal(t, workloads.WorkloadStatusSourceTypes.ROLLUP_RULE, preview.source)
+
+	// Grouped by type, the applications are operational while one host of two is degraded.
+	automatic.RemainingEntitiesRule.Rollup.GroupBy = workloads.WorkloadGroupRemainingEntitiesRuleByTypes.ENTITY_TYPE
+	automatic.RemainingEntitiesRule.Rollup.ThresholdType = workloads.WorkloadRuleThresholdTypeTypes.PERCENTAGE
+	automatic.RemainingEntitiesRule.Rollup.ThresholdValue = 60
+	preview = previewWorkloadStatus(automatic, nil, entities, [][]string{{"a", "b"}})
+	require.Equal(t, testWorkloadOperational, preview.remainingStatus)
+	require.Equal(t, testWorkloadOperational, preview.status)
+
+	// A disabled automatic status is still previewed, but the workload status is unknown.
+	automatic.Enabled = false
+	preview = previewWorkloadStatus(automatic, nil, entities, [][]string{{"a", "b"}})
+	require.Equal(t, testWorkloadUnknown, preview.status)
+	require.Equal(t, workloads.WorkloadStatusSourceTypes.UNKNOWN, preview.source)
+
+	// An enabled static status wins.
+	automatic.Enabled = true
+	static := []workloads.WorkloadStaticStatusInput{{Enabled: true, Status: workloads.WorkloadStatusValueInputTypes.DISRUPTED}}
+	preview = previewWorkloadStatus(automatic, static, entities, [][]string{{"a", "b"}})
+	require.Equal(t, testWorkloadDisrupted, preview.status)
+	require.Equal(t, workloads.WorkloadStatusSourceTypes.STATIC, preview.source)
+}
+
+func TestWorkloadStatusRulesOverlapWarnings(t *testing.T) {
+	automatic := &workloads.WorkloadAutomaticStatusInput{
+		Rules: []workloads.WorkloadRegularRuleInput{
+			{
+				EntityGUIDs:         []common.EntityGUID{"a", "b"},
+				EntitySearchQueries: []workloads.WorkloadEntitySearchQueryInput{{Query: "type = 'HOST'"}},
+			},
+			{
+				EntityGUIDs:         []common.EntityGUID{"a"},
+				EntitySearchQueries: []workloads.WorkloadEntitySearchQueryInput{{Query: "type = 'HOST'"}},
+			},
+		},
+	}
+
+	// Rules may overlap without a remaining entities rule.
+	require.Empty(t, workloadStatusRulesOverlapWarnings(automatic, workloadStatusRuleGUIDs(automatic)))
+
+	automatic.RemainingEntitiesRule = &workloads.WorkloadRemainingEntitiesRuleInput{}
+	diags := workloadStatusRulesOverlapWarnings(automatic, workloadStatusRuleGUIDs(automatic))
+	require.Len(t, diags, 2)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Contains(t, diags[0].Summary, "entity a belongs to more than one rule")
+	require.Contains(t, diags[1].Summary, "type = 'HOST'")
+
+	automatic.Rules[1].EntityGUIDs = []common.EntityGUID{"c"}
+	automatic.Rules[1].EntitySearchQueries = []workloads.WorkloadEntitySearchQueryInput{{Query: "type = 'APPLICATION'"}}
+	require.Empty(t, workloadStatusRulesOverlapWarnings(automatic, workloadStatusRuleGUIDs(automatic)))
+
+	// A GUID of a rule matched by the query of another rule overlaps as well.
+	diags = workloadStatusRulesOverlapWarnings(automatic, [][]string{{"a", "b", "h"}, {"c", "b"}})
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Summary, "entity b belongs to more than one rule")
+}
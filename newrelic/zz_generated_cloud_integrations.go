@@ -0,0 +1,3891 @@
+// Code generated by cloudintegrationsgen from cloud_integrations_catalog.json. DO NOT EDIT.
+
+package newrelic
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/cloud"
+)
+
+// The metrics polling intervals of the AWS services which are not listed in cloudAwsServiceMetricsPollingIntervals.
+var cloudAwsDefaultMetricsPollingIntervals = cloudIntegrationMetricsPollingIntervals{
+	intervals:       []int{300, 900, 1800, 3600},
+	defaultInterval: 300,
+}
+
+// The metrics polling intervals of the AWS services which differ from the default ones, by key.
+var cloudAwsServiceMetricsPollingIntervals = map[string]cloudIntegrationMetricsPollingIntervals{
+	"billing":         {intervals: []int{3600, 21600, 43200, 86400}, defaultInterval: 3600},
+	"ebs":             {intervals: []int{900, 1800, 3600}, defaultInterval: 900},
+	"kinesis":         {intervals: []int{900, 1800, 3600}, defaultInterval: 900},
+	"s3":              {intervals: []int{300, 900, 1800, 3600}, defaultInterval: 3600},
+	"trusted_advisor": {intervals: []int{3600, 21600, 43200, 86400}, defaultInterval: 3600},
+	"x_ray":           {intervals: []int{60, 300, 900, 1800, 3600}, defaultInterval: 60},
+}
+
+// Schema of the catalog services of the newrelic_cloud_aws_integrations resource.
+func cloudAwsCatalogIntegrationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"auto_scaling": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "AutoScaling integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_app_sync": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Appsync integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_athena": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Athena integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_auto_discovery": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Auto Discovery Integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_cognito": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Cognito integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_connect": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Connect integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_direct_connect": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Direct Connect integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_fsx": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Fsx integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_glue": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Glue integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_kinesis_analytics": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Kinesis Analytics integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_media_convert": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Media Convert integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_media_package_vod": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Media PackageVod integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_mq": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Mq integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_msk": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Msk integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_neptune": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Neptune integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_qldb": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Qldb integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_route53resolver": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Route53resolver integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_states": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws states integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_transit_gateway": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Transit Gateway integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_waf": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Waf integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_wafv2": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Wafv2 integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"doc_db": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Doc DB integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"iot": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Iot integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"kinesis_firehose": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Kinesis Firehose integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"ses": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Ses integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+	}
+}
+
+// Schema of the catalog services of the newrelic_cloud_aws_govcloud_integrations resource.
+func cloudAwsGovCloudCatalogIntegrationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"auto_scaling": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "AutoScaling integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_direct_connect": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws Direct Connect integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"aws_states": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Aws states integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_regions": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each AWS region that includes the resources that you want to monitor.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+	}
+}
+
+// Functions enabling and disabling the catalog services of the newrelic_cloud_aws_integrations resource, by key.
+func cloudAwsCatalogIntegrations(enable *cloud.CloudAwsIntegrationsInput, disable *cloud.CloudAwsDisableIntegrationsInput) map[string]enableDisableCloudIntegration {
+	return map[string]enableDisableCloudIntegration{
+		"auto_scaling": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.Autoscaling = expandCloudAwsIntegrationAutoScalingInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.Autoscaling = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_app_sync": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsAppsync = expandCloudAwsIntegrationAppsyncInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsAppsync = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_athena": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsAthena = expandCloudAwsIntegrationAthenaInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsAthena = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_auto_discovery": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsAutoDiscovery = expandCloudAwsIntegrationAutoDiscoveryInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsAutoDiscovery = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_cognito": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsCognito = expandCloudAwsIntegrationCognitoInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsCognito = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_connect": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsConnect = expandCloudAwsIntegrationConnectInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsConnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_direct_connect": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsDirectconnect = expandCloudAwsIntegrationDirectconnectInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsDirectconnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_fsx": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsFsx = expandCloudAwsIntegrationFsxInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsFsx = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_glue": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsGlue = expandCloudAwsIntegrationGlueInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsGlue = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_kinesis_analytics": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsKinesisanalytics = expandCloudAwsIntegrationKinesisAnalyticsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsKinesisanalytics = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_media_convert": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsMediaconvert = expandCloudAwsIntegrationMediaConvertInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsMediaconvert = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_media_package_vod": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsMediapackagevod = expandCloudAwsIntegrationMediaPackageVodInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsMediapackagevod = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_mq": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsMq = expandCloudAwsIntegrationMqInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsMq = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_msk": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsMsk = expandCloudAwsIntegrationMskInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsMsk = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_neptune": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsNeptune = expandCloudAwsIntegrationNeptuneInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsNeptune = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_qldb": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsQldb = expandCloudAwsIntegrationQldbInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsQldb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_route53resolver": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsRoute53resolver = expandCloudAwsIntegrationRoute53resolverInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsRoute53resolver = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_states": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsStates = expandCloudAwsIntegrationStatesInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsStates = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_transit_gateway": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsTransitgateway = expandCloudAwsIntegrationTransitGatewayInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsTransitgateway = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_waf": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsWaf = expandCloudAwsIntegrationWafInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsWaf = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_wafv2": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsWafv2 = expandCloudAwsIntegrationWafv2Input(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsWafv2 = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"doc_db": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsDocdb = expandCloudAwsIntegrationDocDBInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsDocdb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"iot": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.Iot = expandCloudAwsIntegrationIotInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.Iot = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"kinesis_firehose": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.KinesisFirehose = expandCloudAwsIntegrationKinesisFirehoseInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.KinesisFirehose = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"ses": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.Ses = expandCloudAwsIntegrationSesInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.Ses = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+	}
+}
+
+// Functions enabling and disabling the catalog services of the newrelic_cloud_aws_govcloud_integrations resource, by key.
+func cloudAwsGovCloudCatalogIntegrations(enable *cloud.CloudAwsGovcloudIntegrationsInput, disable *cloud.CloudAwsGovcloudDisableIntegrationsInput) map[string]enableDisableCloudIntegration {
+	return map[string]enableDisableCloudIntegration{
+		"auto_scaling": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.Autoscaling = expandCloudAwsIntegrationAutoScalingInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.Autoscaling = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_direct_connect": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsDirectconnect = expandCloudAwsIntegrationDirectconnectInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsDirectconnect = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"aws_states": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AwsStates = expandCloudAwsIntegrationStatesInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AwsStates = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+	}
+}
+
+// Schema of the catalog services of the newrelic_cloud_azure_integrations resource.
+func cloudAzureCatalogIntegrationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_management": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure api management integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"app_gateway": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure app gateway integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"app_service": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure app services",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"containers": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure containers",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"cosmos_db": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure cosmoDB",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"cost_management": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure cost management",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"tag_keys": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify if additional cost data per tag should be collected. This field is case sensitive.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"data_factory": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure data factory",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"event_hub": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure event hub",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"express_route": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure express route",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"firewalls": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure firewalls",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"front_door": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure front door",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"functions": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure functions",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"key_vault": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure key vault",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"load_balancer": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure load balancer",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"logic_apps": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure logic apps",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"machine_learning": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure machine learning",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"maria_db": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure Maria DB",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"mysql": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure mysql",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"mysql_flexible": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure mysql flexible service integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"postgresql": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure postgresql",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"postgresql_flexible": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure postgresql flexible service integration",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"power_bi_dedicated": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure powerBI dedicated",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"redis_cache": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure redis cache",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"service_bus": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure service bus",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"sql": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure sql",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"sql_managed": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure sql managed",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"storage": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure storage",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"virtual_machine": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure virtual machine",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"virtual_networks": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure virtual networks",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"vms": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure Vms",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+		"vpn_gateway": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The Azure vpn gateway",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metrics_polling_interval": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The data polling interval in seconds.",
+					},
+					"resource_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Specify each Resource group associated with the resources that you want to monitor. Filter values are case-sensitive",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			MaxItems: 1,
+		},
+	}
+}
+
+// Functions enabling and disabling the catalog services of the newrelic_cloud_azure_integrations resource, by key.
+func cloudAzureCatalogIntegrations(enable *cloud.CloudAzureIntegrationsInput, disable *cloud.CloudAzureDisableIntegrationsInput) map[string]enableDisableCloudIntegration {
+	return map[string]enableDisableCloudIntegration{
+		"api_management": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureAPImanagement = expandCloudAzureIntegrationAPIManagementInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureAPImanagement = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"app_gateway": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureAppgateway = expandCloudAzureIntegrationAppGatewayInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureAppgateway = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"app_service": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureAppservice = expandCloudAzureIntegrationAppServiceInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureAppservice = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"containers": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureContainers = expandCloudAzureIntegrationContainersInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureContainers = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"cosmos_db": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureCosmosdb = expandCloudAzureIntegrationCosmosdbInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureCosmosdb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"cost_management": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureCostmanagement = expandCloudAzureIntegrationCostManagementInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureCostmanagement = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"data_factory": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureDatafactory = expandCloudAzureIntegrationDataFactoryInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureDatafactory = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"event_hub": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureEventhub = expandCloudAzureIntegrationEventHubInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureEventhub = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"express_route": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureExpressroute = expandCloudAzureIntegrationExpressRouteInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureExpressroute = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"firewalls": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureFirewalls = expandCloudAzureIntegrationFirewallsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureFirewalls = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"front_door": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureFrontdoor = expandCloudAzureIntegrationFrontDoorInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureFrontdoor = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"functions": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureFunctions = expandCloudAzureIntegrationFunctionsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureFunctions = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"key_vault": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureKeyvault = expandCloudAzureIntegrationKeyVaultInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureKeyvault = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"load_balancer": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureLoadbalancer = expandCloudAzureIntegrationLoadBalancerInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureLoadbalancer = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"logic_apps": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureLogicapps = expandCloudAzureIntegrationLogicAppsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureLogicapps = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"machine_learning": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureMachinelearning = expandCloudAzureIntegrationMachineLearningInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureMachinelearning = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"maria_db": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureMariadb = expandCloudAzureIntegrationMariadbInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureMariadb = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"mysql": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureMysql = expandCloudAzureIntegrationMysqlInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureMysql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"mysql_flexible": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureMysqlflexible = expandCloudAzureIntegrationMysqlFlexibleInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureMysqlflexible = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"postgresql": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzurePostgresql = expandCloudAzureIntegrationPostgresqlInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzurePostgresql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"postgresql_flexible": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzurePostgresqlflexible = expandCloudAzureIntegrationPostgresqlFlexibleInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzurePostgresqlflexible = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"power_bi_dedicated": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzurePowerbidedicated = expandCloudAzureIntegrationPowerBiDedicatedInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzurePowerbidedicated = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"redis_cache": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureRediscache = expandCloudAzureIntegrationRedisCacheInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureRediscache = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"service_bus": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureServicebus = expandCloudAzureIntegrationServiceBusInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureServicebus = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"sql": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureSql = expandCloudAzureIntegrationSQLInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureSql = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"sql_managed": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureSqlmanaged = expandCloudAzureIntegrationSQLManagedInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureSqlmanaged = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"storage": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureStorage = expandCloudAzureIntegrationStorageInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureStorage = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"virtual_machine": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureVirtualmachine = expandCloudAzureIntegrationVirtualMachineInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureVirtualmachine = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"virtual_networks": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureVirtualnetworks = expandCloudAzureIntegrationVirtualNetworksInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureVirtualnetworks = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"vms": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureVms = expandCloudAzureIntegrationVmsInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureVms = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+		"vpn_gateway": {
+			enableFunc: func(a []interface{}, id int) {
+				enable.AzureVpngateways = expandCloudAzureIntegrationVpnGatewayInput(a, id)
+			},
+			disableFunc: func(id int) {
+				disable.AzureVpngateways = []cloud.CloudDisableAccountIntegrationInput{{LinkedAccountId: id}}
+			},
+		},
+	}
+}
+
+// Sets the catalog service of the newrelic_cloud_azure_integrations resource matching the given integration.
+// Returns false when the integration is not a catalog service.
+func flattenCloudAzureCatalogIntegration(d *schema.ResourceData, integration cloud.CloudIntegrationInterface) bool {
+	switch t := integration.(type) {
+	case *cloud.CloudAzureAPImanagementIntegration:
+		_ = d.Set("api_management", flattenCloudAzureAPIManagementIntegration(t))
+	case *cloud.CloudAzureAppgatewayIntegration:
+		_ = d.Set("app_gateway", flattenCloudAzureAppGatewayIntegration(t))
+	case *cloud.CloudAzureAppserviceIntegration:
+		_ = d.Set("app_service", flattenCloudAzureAppServiceIntegration(t))
+	case *cloud.CloudAzureContainersIntegration:
+		_ = d.Set("containers", flattenCloudAzureContainersIntegration(t))
+	case *cloud.CloudAzureCosmosdbIntegration:
+		_ = d.Set("cosmos_db", flattenCloudAzureCosmosdbIntegration(t))
+	case *cloud.CloudAzureCostmanagementIntegration:
+		_ = d.Set("cost_management", flattenCloudAzureCostManagementIntegration(t))
+	case *cloud.CloudAzureDatafactoryIntegration:
+		_ = d.Set("data_factory", flattenCloudAzureDataFactoryIntegration(t))
+	case *cloud.CloudAzureEventhubIntegration:
+		_ = d.Set("event_hub", flattenCloudAzureEventHubIntegration(t))
+	case *cloud.CloudAzureExpressrouteIntegration:
+		_ = d.Set("express_route", flattenCloudAzureExpressRouteIntegration(t))
+	case *cloud.CloudAzureFirewallsIntegration:
+		_ = d.Set("firewalls", flattenCloudAzureFirewallsIntegration(t))
+	case *cloud.CloudAzureFrontdoorIntegration:
+		_ = d.Set("front_door", flattenCloudAzureFrontDoorIntegration(t))
+	case *cloud.CloudAzureFunctionsIntegration:
+		_ = d.Set("functions", flattenCloudAzureFunctionsIntegration(t))
+	case *cloud.CloudAzureKeyvaultIntegration:
+		_ = d.Set("key_vault", flattenCloudAzureKeyVaultIntegration(t))
+	case *cloud.CloudAzureLoadbalancerIntegration:
+		_ = d.Set("load_balancer", flattenCloudAzureLoadBalancerIntegration(t))
+	case *cloud.CloudAzureLogicappsIntegration:
+		_ = d.Set("logic_apps", flattenCloudAzureLogicAppsIntegration(t))
+	case *cloud.CloudAzureMachinelearningIntegration:
+		_ = d.Set("machine_learning", flattenCloudAzureMachineLearningIntegration(t))
+	case *cloud.CloudAzureMariadbIntegration:
+		_ = d.Set("maria_db", flattenCloudAzureMariadbIntegration(t))
+	case *cloud.CloudAzureMysqlIntegration:
+		_ = d.Set("mysql", flattenCloudAzureMysqlIntegration(t))
+	case *cloud.CloudAzureMysqlflexibleIntegration:
+		_ = d.Set("mysql_flexible", flattenCloudAzureMysqlFlexibleIntegration(t))
+	case *cloud.CloudAzurePostgresqlIntegration:
+		_ = d.Set("postgresql", flattenCloudAzurePostgresqlIntegration(t))
+	case *cloud.CloudAzurePostgresqlflexibleIntegration:
+		_ = d.Set("postgresql_flexible", flattenCloudAzurePostgresqlFlexibleIntegration(t))
+	case *cloud.CloudAzurePowerbidedicatedIntegration:
+		_ = d.Set("power_bi_dedicated", flattenCloudAzurePowerBiDedicatedIntegration(t))
+	case *cloud.CloudAzureRediscacheIntegration:
+		_ = d.Set("redis_cache", flattenCloudAzureRedisCacheIntegration(t))
+	case *cloud.CloudAzureServicebusIntegration:
+		_ = d.Set("service_bus", flattenCloudAzureServiceBusIntegration(t))
+	case *cloud.CloudAzureSqlIntegration:
+		_ = d.Set("sql", flattenCloudAzureSQLIntegration(t))
+	case *cloud.CloudAzureSqlmanagedIntegration:
+		_ = d.Set("sql_managed", flattenCloudAzureSQLManagedIntegration(t))
+	case *cloud.CloudAzureStorageIntegration:
+		_ = d.Set("storage", flattenCloudAzureStorageIntegration(t))
+	case *cloud.CloudAzureVirtualmachineIntegration:
+		_ = d.Set("virtual_machine", flattenCloudAzureVirtualMachineIntegration(t))
+	case *cloud.CloudAzureVirtualnetworksIntegration:
+		_ = d.Set("virtual_networks", flattenCloudAzureVirtualNetworksIntegration(t))
+	case *cloud.CloudAzureVmsIntegration:
+		_ = d.Set("vms", flattenCloudAzureVmsIntegration(t))
+	case *cloud.CloudAzureVpngatewaysIntegration:
+		_ = d.Set("vpn_gateway", flattenCloudAzureVpnGatewayIntegration(t))
+	default:
+		return false
+	}
+
+	return true
+}
+
+// Sets the catalog service of the newrelic_cloud_aws_integrations resource matching the given integration.
+// Returns false when the integration is not a catalog service.
+func flattenCloudAwsCatalogIntegration(d *schema.ResourceData, integration cloud.CloudIntegrationInterface) bool {
+	switch t := integration.(type) {
+	case *cloud.CloudAutoscalingIntegration:
+		_ = d.Set("auto_scaling", flattenCloudAwsAutoScalingIntegration(t))
+	case *cloud.CloudAwsAppsyncIntegration:
+		_ = d.Set("aws_app_sync", flattenCloudAwsAppsyncIntegration(t))
+	case *cloud.CloudAwsAthenaIntegration:
+		_ = d.Set("aws_athena", flattenCloudAwsAthenaIntegration(t))
+	case *cloud.CloudAwsAutoDiscoveryIntegration:
+		_ = d.Set("aws_auto_discovery", flattenCloudAwsAutoDiscoveryIntegration(t))
+	case *cloud.CloudAwsCognitoIntegration:
+		_ = d.Set("aws_cognito", flattenCloudAwsCognitoIntegration(t))
+	case *cloud.CloudAwsConnectIntegration:
+		_ = d.Set("aws_connect", flattenCloudAwsConnectIntegration(t))
+	case *cloud.CloudAwsDirectconnectIntegration:
+		_ = d.Set("aws_direct_connect", flattenCloudAwsDirectconnectIntegration(t))
+	case *cloud.CloudAwsFsxIntegration:
+		_ = d.Set("aws_fsx", flattenCloudAwsFsxIntegration(t))
+	case *cloud.CloudAwsGlueIntegration:
+		_ = d.Set("aws_glue", flattenCloudAwsGlueIntegration(t))
+	case *cloud.CloudAwsKinesisanalyticsIntegration:
+		_ = d.Set("aws_kinesis_analytics", flattenCloudAwsKinesisAnalyticsIntegration(t))
+	case *cloud.CloudAwsMediaconvertIntegration:
+		_ = d.Set("aws_media_convert", flattenCloudAwsMediaConvertIntegration(t))
+	case *cloud.CloudAwsMediapackagevodIntegration:
+		_ = d.Set("aws_media_package_vod", flattenCloudAwsMediaPackageVodIntegration(t))
+	case *cloud.CloudAwsMqIntegration:
+		_ = d.Set("aws_mq", flattenCloudAwsMqIntegration(t))
+	case *cloud.CloudAwsMskIntegration:
+		_ = d.Set("aws_msk", flattenCloudAwsMskIntegration(t))
+	case *cloud.CloudAwsNeptuneIntegration:
+		_ = d.Set("aws_neptune", flattenCloudAwsNeptuneIntegration(t))
+	case *cloud.CloudAwsQldbIntegration:
+		_ = d.Set("aws_qldb", flattenCloudAwsQldbIntegration(t))
+	case *cloud.CloudAwsRoute53resolverIntegration:
+		_ = d.Set("aws_route53resolver", flattenCloudAwsRoute53resolverIntegration(t))
+	case *cloud.CloudAwsStatesIntegration:
+		_ = d.Set("aws_states", flattenCloudAwsStatesIntegration(t))
+	case *cloud.CloudAwsTransitgatewayIntegration:
+		_ = d.Set("aws_transit_gateway", flattenCloudAwsTransitGatewayIntegration(t))
+	case *cloud.CloudAwsWafIntegration:
+		_ = d.Set("aws_waf", flattenCloudAwsWafIntegration(t))
+	case *cloud.CloudAwsWafv2Integration:
+		_ = d.Set("aws_wafv2", flattenCloudAwsWafv2Integration(t))
+	case *cloud.CloudAwsDocdbIntegration:
+		_ = d.Set("doc_db", flattenCloudAwsDocDBIntegration(t))
+	case *cloud.CloudIotIntegration:
+		_ = d.Set("iot", flattenCloudAwsIotIntegration(t))
+	case *cloud.CloudKinesisFirehoseIntegration:
+		_ = d.Set("kinesis_firehose", flattenCloudAwsKinesisFirehoseIntegration(t))
+	case *cloud.CloudSesIntegration:
+		_ = d.Set("ses", flattenCloudAwsSesIntegration(t))
+	default:
+		return false
+	}
+
+	return true
+}
+
+// Sets the catalog service of the newrelic_cloud_aws_govcloud_integrations resource matching the given integration.
+// Returns false when the integration is not a catalog service.
+func flattenCloudAwsGovCloudCatalogIntegration(d *schema.ResourceData, integration cloud.CloudIntegrationInterface) bool {
+	switch t := integration.(type) {
+	case *cloud.CloudAutoscalingIntegration:
+		_ = d.Set("auto_scaling", flattenCloudAwsAutoScalingIntegration(t))
+	case *cloud.CloudAwsDirectconnectIntegration:
+		_ = d.Set("aws_direct_connect", flattenCloudAwsDirectconnectIntegration(t))
+	case *cloud.CloudAwsStatesIntegration:
+		_ = d.Set("aws_states", flattenCloudAwsStatesIntegration(t))
+	default:
+		return false
+	}
+
+	return true
+}
+
+func expandCloudAwsIntegrationAutoScalingInput(b []interface{}, linkedAccountID int) []cloud.CloudAutoscalingIntegrationInput {
+	expanded := make([]cloud.CloudAutoscalingIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsAutoScalingIntegration(in *cloud.CloudAutoscalingIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationAppsyncInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsAppsyncIntegrationInput {
+	expanded := make([]cloud.CloudAwsAppsyncIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsAppsyncIntegration(in *cloud.CloudAwsAppsyncIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationAthenaInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsAthenaIntegrationInput {
+	expanded := make([]cloud.CloudAwsAthenaIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsAthenaIntegration(in *cloud.CloudAwsAthenaIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationAutoDiscoveryInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsAutoDiscoveryIntegrationInput {
+	expanded := make([]cloud.CloudAwsAutoDiscoveryIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsAutoDiscoveryIntegration(in *cloud.CloudAwsAutoDiscoveryIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationCognitoInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsCognitoIntegrationInput {
+	expanded := make([]cloud.CloudAwsCognitoIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsCognitoIntegration(in *cloud.CloudAwsCognitoIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationConnectInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsConnectIntegrationInput {
+	expanded := make([]cloud.CloudAwsConnectIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsConnectIntegration(in *cloud.CloudAwsConnectIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationDirectconnectInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsDirectconnectIntegrationInput {
+	expanded := make([]cloud.CloudAwsDirectconnectIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsDirectconnectIntegration(in *cloud.CloudAwsDirectconnectIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationFsxInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsFsxIntegrationInput {
+	expanded := make([]cloud.CloudAwsFsxIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsFsxIntegration(in *cloud.CloudAwsFsxIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationGlueInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsGlueIntegrationInput {
+	expanded := make([]cloud.CloudAwsGlueIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsGlueIntegration(in *cloud.CloudAwsGlueIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationKinesisAnalyticsInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsKinesisanalyticsIntegrationInput {
+	expanded := make([]cloud.CloudAwsKinesisanalyticsIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsKinesisAnalyticsIntegration(in *cloud.CloudAwsKinesisanalyticsIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationMediaConvertInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsMediaconvertIntegrationInput {
+	expanded := make([]cloud.CloudAwsMediaconvertIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsMediaConvertIntegration(in *cloud.CloudAwsMediaconvertIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationMediaPackageVodInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsMediapackagevodIntegrationInput {
+	expanded := make([]cloud.CloudAwsMediapackagevodIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsMediaPackageVodIntegration(in *cloud.CloudAwsMediapackagevodIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationMqInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsMqIntegrationInput {
+	expanded := make([]cloud.CloudAwsMqIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsMqIntegration(in *cloud.CloudAwsMqIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationMskInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsMskIntegrationInput {
+	expanded := make([]cloud.CloudAwsMskIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsMskIntegration(in *cloud.CloudAwsMskIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationNeptuneInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsNeptuneIntegrationInput {
+	expanded := make([]cloud.CloudAwsNeptuneIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsNeptuneIntegration(in *cloud.CloudAwsNeptuneIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationQldbInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsQldbIntegrationInput {
+	expanded := make([]cloud.CloudAwsQldbIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsQldbIntegration(in *cloud.CloudAwsQldbIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationRoute53resolverInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsRoute53resolverIntegrationInput {
+	expanded := make([]cloud.CloudAwsRoute53resolverIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsRoute53resolverIntegration(in *cloud.CloudAwsRoute53resolverIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationStatesInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsStatesIntegrationInput {
+	expanded := make([]cloud.CloudAwsStatesIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsStatesIntegration(in *cloud.CloudAwsStatesIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationTransitGatewayInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsTransitgatewayIntegrationInput {
+	expanded := make([]cloud.CloudAwsTransitgatewayIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsTransitGatewayIntegration(in *cloud.CloudAwsTransitgatewayIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationWafInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsWafIntegrationInput {
+	expanded := make([]cloud.CloudAwsWafIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsWafIntegration(in *cloud.CloudAwsWafIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationWafv2Input(b []interface{}, linkedAccountID int) []cloud.CloudAwsWafv2IntegrationInput {
+	expanded := make([]cloud.CloudAwsWafv2IntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsWafv2Integration(in *cloud.CloudAwsWafv2Integration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationDocDBInput(b []interface{}, linkedAccountID int) []cloud.CloudAwsDocdbIntegrationInput {
+	expanded := make([]cloud.CloudAwsDocdbIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsDocDBIntegration(in *cloud.CloudAwsDocdbIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationIotInput(b []interface{}, linkedAccountID int) []cloud.CloudIotIntegrationInput {
+	expanded := make([]cloud.CloudIotIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsIotIntegration(in *cloud.CloudIotIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationKinesisFirehoseInput(b []interface{}, linkedAccountID int) []cloud.CloudKinesisFirehoseIntegrationInput {
+	expanded := make([]cloud.CloudKinesisFirehoseIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsKinesisFirehoseIntegration(in *cloud.CloudKinesisFirehoseIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAwsIntegrationSesInput(b []interface{}, linkedAccountID int) []cloud.CloudSesIntegrationInput {
+	expanded := make([]cloud.CloudSesIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["aws_regions"]; ok {
+			expanded[i].AwsRegions = expandStringSlice(v.([]interface{}))
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAwsSesIntegration(in *cloud.CloudSesIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["aws_regions"] = in.AwsRegions
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationAPIManagementInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureAPImanagementIntegrationInput {
+	expanded := make([]cloud.CloudAzureAPImanagementIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureAPIManagementIntegration(in *cloud.CloudAzureAPImanagementIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationAppGatewayInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureAppgatewayIntegrationInput {
+	expanded := make([]cloud.CloudAzureAppgatewayIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureAppGatewayIntegration(in *cloud.CloudAzureAppgatewayIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationAppServiceInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureAppserviceIntegrationInput {
+	expanded := make([]cloud.CloudAzureAppserviceIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureAppServiceIntegration(in *cloud.CloudAzureAppserviceIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationContainersInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureContainersIntegrationInput {
+	expanded := make([]cloud.CloudAzureContainersIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureContainersIntegration(in *cloud.CloudAzureContainersIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationCosmosdbInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureCosmosdbIntegrationInput {
+	expanded := make([]cloud.CloudAzureCosmosdbIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureCosmosdbIntegration(in *cloud.CloudAzureCosmosdbIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationCostManagementInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureCostmanagementIntegrationInput {
+	expanded := make([]cloud.CloudAzureCostmanagementIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["tag_keys"]; ok {
+			expanded[i].TagKeys = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureCostManagementIntegration(in *cloud.CloudAzureCostmanagementIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["tag_keys"] = in.TagKeys
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationDataFactoryInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureDatafactoryIntegrationInput {
+	expanded := make([]cloud.CloudAzureDatafactoryIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureDataFactoryIntegration(in *cloud.CloudAzureDatafactoryIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationEventHubInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureEventhubIntegrationInput {
+	expanded := make([]cloud.CloudAzureEventhubIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureEventHubIntegration(in *cloud.CloudAzureEventhubIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationExpressRouteInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureExpressrouteIntegrationInput {
+	expanded := make([]cloud.CloudAzureExpressrouteIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureExpressRouteIntegration(in *cloud.CloudAzureExpressrouteIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationFirewallsInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureFirewallsIntegrationInput {
+	expanded := make([]cloud.CloudAzureFirewallsIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureFirewallsIntegration(in *cloud.CloudAzureFirewallsIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationFrontDoorInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureFrontdoorIntegrationInput {
+	expanded := make([]cloud.CloudAzureFrontdoorIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureFrontDoorIntegration(in *cloud.CloudAzureFrontdoorIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationFunctionsInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureFunctionsIntegrationInput {
+	expanded := make([]cloud.CloudAzureFunctionsIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureFunctionsIntegration(in *cloud.CloudAzureFunctionsIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationKeyVaultInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureKeyvaultIntegrationInput {
+	expanded := make([]cloud.CloudAzureKeyvaultIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureKeyVaultIntegration(in *cloud.CloudAzureKeyvaultIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationLoadBalancerInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureLoadbalancerIntegrationInput {
+	expanded := make([]cloud.CloudAzureLoadbalancerIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureLoadBalancerIntegration(in *cloud.CloudAzureLoadbalancerIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationLogicAppsInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureLogicappsIntegrationInput {
+	expanded := make([]cloud.CloudAzureLogicappsIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureLogicAppsIntegration(in *cloud.CloudAzureLogicappsIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationMachineLearningInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureMachinelearningIntegrationInput {
+	expanded := make([]cloud.CloudAzureMachinelearningIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureMachineLearningIntegration(in *cloud.CloudAzureMachinelearningIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationMariadbInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureMariadbIntegrationInput {
+	expanded := make([]cloud.CloudAzureMariadbIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureMariadbIntegration(in *cloud.CloudAzureMariadbIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationMysqlInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureMysqlIntegrationInput {
+	expanded := make([]cloud.CloudAzureMysqlIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureMysqlIntegration(in *cloud.CloudAzureMysqlIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationMysqlFlexibleInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureMysqlflexibleIntegrationInput {
+	expanded := make([]cloud.CloudAzureMysqlflexibleIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureMysqlFlexibleIntegration(in *cloud.CloudAzureMysqlflexibleIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationPostgresqlInput(b []interface{}, linkedAccountID int) []cloud.CloudAzurePostgresqlIntegrationInput {
+	expanded := make([]cloud.CloudAzurePostgresqlIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzurePostgresqlIntegration(in *cloud.CloudAzurePostgresqlIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationPostgresqlFlexibleInput(b []interface{}, linkedAccountID int) []cloud.CloudAzurePostgresqlflexibleIntegrationInput {
+	expanded := make([]cloud.CloudAzurePostgresqlflexibleIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzurePostgresqlFlexibleIntegration(in *cloud.CloudAzurePostgresqlflexibleIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationPowerBiDedicatedInput(b []interface{}, linkedAccountID int) []cloud.CloudAzurePowerbidedicatedIntegrationInput {
+	expanded := make([]cloud.CloudAzurePowerbidedicatedIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzurePowerBiDedicatedIntegration(in *cloud.CloudAzurePowerbidedicatedIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationRedisCacheInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureRediscacheIntegrationInput {
+	expanded := make([]cloud.CloudAzureRediscacheIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureRedisCacheIntegration(in *cloud.CloudAzureRediscacheIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationServiceBusInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureServicebusIntegrationInput {
+	expanded := make([]cloud.CloudAzureServicebusIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureServiceBusIntegration(in *cloud.CloudAzureServicebusIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationSQLInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureSqlIntegrationInput {
+	expanded := make([]cloud.CloudAzureSqlIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureSQLIntegration(in *cloud.CloudAzureSqlIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationSQLManagedInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureSqlmanagedIntegrationInput {
+	expanded := make([]cloud.CloudAzureSqlmanagedIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureSQLManagedIntegration(in *cloud.CloudAzureSqlmanagedIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationStorageInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureStorageIntegrationInput {
+	expanded := make([]cloud.CloudAzureStorageIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureStorageIntegration(in *cloud.CloudAzureStorageIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationVirtualMachineInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureVirtualmachineIntegrationInput {
+	expanded := make([]cloud.CloudAzureVirtualmachineIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureVirtualMachineIntegration(in *cloud.CloudAzureVirtualmachineIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationVirtualNetworksInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureVirtualnetworksIntegrationInput {
+	expanded := make([]cloud.CloudAzureVirtualnetworksIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureVirtualNetworksIntegration(in *cloud.CloudAzureVirtualnetworksIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationVmsInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureVmsIntegrationInput {
+	expanded := make([]cloud.CloudAzureVmsIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureVmsIntegration(in *cloud.CloudAzureVmsIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}
+
+func expandCloudAzureIntegrationVpnGatewayInput(b []interface{}, linkedAccountID int) []cloud.CloudAzureVpngatewaysIntegrationInput {
+	expanded := make([]cloud.CloudAzureVpngatewaysIntegrationInput, len(b))
+
+	for i, raw := range b {
+		expanded[i].LinkedAccountId = linkedAccountID
+
+		in, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := in["metrics_polling_interval"]; ok {
+			expanded[i].MetricsPollingInterval = v.(int)
+		}
+
+		if v, ok := in["resource_groups"]; ok {
+			expanded[i].ResourceGroups = expandStringSlice(v.([]interface{}))
+		}
+	}
+
+	return expanded
+}
+
+func flattenCloudAzureVpnGatewayIntegration(in *cloud.CloudAzureVpngatewaysIntegration) []interface{} {
+	out := make(map[string]interface{})
+
+	out["metrics_polling_interval"] = in.MetricsPollingInterval
+	out["resource_groups"] = in.ResourceGroups
+
+	return []interface{}{out}
+}